@@ -213,6 +213,16 @@ func (app *Application) makeAuthContext(ctx http.Context, claims *Claims, token
 	})
 }
 
+// UserKey Get the key of the user whose token is parsed by the guard in the request, it's empty if there isn't one.
+func UserKey(ctx http.Context, guard string) string {
+	auth, ok := ctx.Value(ctxKey).(Auth)
+	if !ok || auth[guard] == nil || auth[guard].Claims == nil || auth[guard].Token == "" {
+		return ""
+	}
+
+	return auth[guard].Claims.Key
+}
+
 func tokenIsDisabled(token string) bool {
 	return facades.Cache.GetBool(getDisabledCacheKey(token), false)
 }
@@ -182,6 +182,21 @@ func (r *Redis) Forever(key string, value interface{}) bool {
 	return true
 }
 
+// Increment the value of an item in the cache, the item is created with the value if it doesn't exist.
+func (r *Redis) Increment(key string, value ...int) (int, error) {
+	increment := 1
+	if len(value) > 0 {
+		increment = value[0]
+	}
+
+	res, err := r.redis.IncrBy(r.ctx, r.prefix+key, int64(increment)).Result()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(res), nil
+}
+
 //Forget Remove an item from the cache.
 func (r *Redis) Forget(key string) bool {
 	_, err := r.redis.Del(r.ctx, r.prefix+key).Result()
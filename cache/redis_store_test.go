@@ -119,6 +119,19 @@ func TestForever(t *testing.T) {
 	assert.Equal(t, "goravel", r.Get("test-forever", nil))
 }
 
+func TestIncrement(t *testing.T) {
+	r := instance().(cache.Incrementer)
+
+	res, err := r.Increment("test-increment")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, res)
+
+	res, err = r.Increment("test-increment", 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, res)
+	assert.True(t, instance().Forget("test-increment"))
+}
+
 func TestForget(t *testing.T) {
 	r := instance()
 
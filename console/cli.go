@@ -79,6 +79,17 @@ func (c *Cli) Run(args []string, exitIfArtisan bool) {
 func flagsToCliFlags(flags []command.Flag) []cli.Flag {
 	var cliFlags []cli.Flag
 	for _, flag := range flags {
+		if flag.IsBool {
+			cliFlags = append(cliFlags, &cli.BoolFlag{
+				Name:     flag.Name,
+				Aliases:  flag.Aliases,
+				Usage:    flag.Usage,
+				Required: flag.Required,
+			})
+
+			continue
+		}
+
 		cliFlags = append(cliFlags, &cli.StringFlag{
 			Name:     flag.Name,
 			Aliases:  flag.Aliases,
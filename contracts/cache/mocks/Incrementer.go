@@ -0,0 +1,53 @@
+// Code generated by mockery v2.12.3. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// Incrementer is an autogenerated mock type for the Incrementer type
+type Incrementer struct {
+	mock.Mock
+}
+
+// Increment provides a mock function with given fields: key, value
+func (_m *Incrementer) Increment(key string, value ...int) (int, error) {
+	_va := make([]interface{}, len(value))
+	for _i := range value {
+		_va[_i] = value[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, key)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(string, ...int) int); ok {
+		r0 = rf(key, value...)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, ...int) error); ok {
+		r1 = rf(key, value...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type NewIncrementerT interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewIncrementer creates a new instance of Incrementer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewIncrementer(t NewIncrementerT) *Incrementer {
+	mock := &Incrementer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -32,3 +32,10 @@ type Store interface {
 	//Flush Remove all items from the cache.
 	Flush() bool
 }
+
+//go:generate mockery --name=Incrementer
+type Incrementer interface {
+	// Increment the value of an item in the cache, the item is created with the value if it doesn't exist.
+	// It's optional for the stores, RateLimiter increments the attempts by Get and Put if the store doesn't implement it.
+	Increment(key string, value ...int) (int, error)
+}
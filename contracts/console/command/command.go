@@ -17,4 +17,6 @@ type Flag struct {
 	Usage    string
 	Required bool
 	Value    string
+	// IsBool The flag doesn't need a value, the option is "true" if it is set: --json
+	IsBool bool
 }
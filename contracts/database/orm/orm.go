@@ -43,6 +43,9 @@ type Query interface {
 	Offset(offset int) Query
 	Order(value interface{}) Query
 	OrWhere(query interface{}, args ...interface{}) Query
+	// Paginate Find the records of the page into dest and count the total records of the query, the page starts at 1.
+	// The page and the limit less than 1 are treated as 1, the same as http.ResourceCollection.Paginate.
+	Paginate(page, limit int, dest interface{}, total *int64) error
 	Pluck(column string, dest interface{}) error
 	Raw(sql string, values ...interface{}) Query
 	Save(value interface{}) error
@@ -12,29 +12,23 @@ type File struct {
 	mock.Mock
 }
 
-// ClientOriginalExtension provides a mock function with given fields:
-func (_m *File) ClientOriginalExtension() string {
-	ret := _m.Called()
-
-	var r0 string
-	if rf, ok := ret.Get(0).(func() string); ok {
-		r0 = rf()
-	} else {
-		r0 = ret.Get(0).(string)
+// AllowedMimeTypes provides a mock function with given fields: mimeTypes
+func (_m *File) AllowedMimeTypes(mimeTypes ...string) filesystem.File {
+	_va := make([]interface{}, len(mimeTypes))
+	for _i := range mimeTypes {
+		_va[_i] = mimeTypes[_i]
 	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
-	return r0
-}
-
-// ClientOriginalName provides a mock function with given fields:
-func (_m *File) ClientOriginalName() string {
-	ret := _m.Called()
-
-	var r0 string
-	if rf, ok := ret.Get(0).(func() string); ok {
-		r0 = rf()
+	var r0 filesystem.File
+	if rf, ok := ret.Get(0).(func(...string) filesystem.File); ok {
+		r0 = rf(mimeTypes...)
 	} else {
-		r0 = ret.Get(0).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(filesystem.File)
+		}
 	}
 
 	return r0
@@ -91,6 +85,34 @@ func (_m *File) File() string {
 	return r0
 }
 
+// GetClientOriginalExtension provides a mock function with given fields:
+func (_m *File) GetClientOriginalExtension() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// GetClientOriginalName provides a mock function with given fields:
+func (_m *File) GetClientOriginalName() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // HashName provides a mock function with given fields: path
 func (_m *File) HashName(path ...string) string {
 	_va := make([]interface{}, len(path))
@@ -111,6 +133,27 @@ func (_m *File) HashName(path ...string) string {
 	return r0
 }
 
+// MimeType provides a mock function with given fields:
+func (_m *File) MimeType() (string, error) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Store provides a mock function with given fields: path
 func (_m *File) Store(path string) (string, error) {
 	ret := _m.Called(path)
@@ -4,8 +4,10 @@ package mocks
 
 import (
 	context "context"
+	io "io"
 
 	filesystem "github.com/goravel/framework/contracts/filesystem"
+
 	mock "github.com/stretchr/testify/mock"
 
 	time "time"
@@ -227,6 +229,20 @@ func (_m *Storage) PutFileAs(path string, source filesystem.File, name string) (
 	return r0, r1
 }
 
+// PutStream provides a mock function with given fields: file, content
+func (_m *Storage) PutStream(file string, content io.Reader) error {
+	ret := _m.Called(file, content)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader) error); ok {
+		r0 = rf(file, content)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Size provides a mock function with given fields: file
 func (_m *Storage) Size(file string) (int64, error) {
 	ret := _m.Called(file)
@@ -0,0 +1,198 @@
+// Code generated by mockery v2.12.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	filesystem "github.com/goravel/framework/contracts/filesystem"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// StreamedFile is an autogenerated mock type for the StreamedFile type
+type StreamedFile struct {
+	mock.Mock
+}
+
+// AllowedMimeTypes provides a mock function with given fields: mimeTypes
+func (_m *StreamedFile) AllowedMimeTypes(mimeTypes ...string) filesystem.StreamedFile {
+	_va := make([]interface{}, len(mimeTypes))
+	for _i := range mimeTypes {
+		_va[_i] = mimeTypes[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 filesystem.StreamedFile
+	if rf, ok := ret.Get(0).(func(...string) filesystem.StreamedFile); ok {
+		r0 = rf(mimeTypes...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(filesystem.StreamedFile)
+		}
+	}
+
+	return r0
+}
+
+// Disk provides a mock function with given fields: disk
+func (_m *StreamedFile) Disk(disk string) filesystem.StreamedFile {
+	ret := _m.Called(disk)
+
+	var r0 filesystem.StreamedFile
+	if rf, ok := ret.Get(0).(func(string) filesystem.StreamedFile); ok {
+		r0 = rf(disk)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(filesystem.StreamedFile)
+		}
+	}
+
+	return r0
+}
+
+// GetClientOriginalExtension provides a mock function with given fields:
+func (_m *StreamedFile) GetClientOriginalExtension() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// GetClientOriginalName provides a mock function with given fields:
+func (_m *StreamedFile) GetClientOriginalName() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// HashName provides a mock function with given fields: path
+func (_m *StreamedFile) HashName(path ...string) string {
+	_va := make([]interface{}, len(path))
+	for _i := range path {
+		_va[_i] = path[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(...string) string); ok {
+		r0 = rf(path...)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// MimeType provides a mock function with given fields:
+func (_m *StreamedFile) MimeType() (string, error) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Read provides a mock function with given fields: p
+func (_m *StreamedFile) Read(p []byte) (int, error) {
+	ret := _m.Called(p)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func([]byte) int); ok {
+		r0 = rf(p)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]byte) error); ok {
+		r1 = rf(p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store provides a mock function with given fields: path
+func (_m *StreamedFile) Store(path string) (string, error) {
+	ret := _m.Called(path)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(path)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(path)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StoreAs provides a mock function with given fields: path, name
+func (_m *StreamedFile) StoreAs(path string, name string) (string, error) {
+	ret := _m.Called(path, name)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, string) string); ok {
+		r0 = rf(path, name)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(path, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type NewStreamedFileT interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewStreamedFile creates a new instance of StreamedFile. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewStreamedFile(t NewStreamedFileT) *StreamedFile {
+	mock := &StreamedFile{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -2,6 +2,7 @@ package filesystem
 
 import (
 	"context"
+	"io"
 	"time"
 )
 
@@ -15,6 +16,8 @@ type Storage interface {
 type Driver interface {
 	WithContext(ctx context.Context) Driver
 	Put(file, content string) error
+	// PutStream Put the content read from the reader to the file, the content isn't buffered in a temp file.
+	PutStream(file string, content io.Reader) error
 	PutFile(path string, source File) (string, error)
 	PutFileAs(path string, source File, name string) (string, error)
 	Get(file string) (string, error)
@@ -46,6 +49,26 @@ type File interface {
 	GetClientOriginalExtension() string
 	HashName(path ...string) string
 	Extension() (string, error)
+	// MimeType Get the MIME type sniffed from the content, the Content-Type sent by the client isn't trusted.
+	MimeType() (string, error)
+	// AllowedMimeTypes Restrict the MIME types of the file, Store returns an error if the type isn't allowed: image/*
+	AllowedMimeTypes(mimeTypes ...string) File
+}
+
+//go:generate mockery --name=StreamedFile
+type StreamedFile interface {
+	// Reader The content is read from the request body directly, so the file can be read or stored once only.
+	io.Reader
+	Disk(disk string) StreamedFile
+	Store(path string) (string, error)
+	StoreAs(path string, name string) (string, error)
+	GetClientOriginalName() string
+	GetClientOriginalExtension() string
+	HashName(path ...string) string
+	// MimeType Get the MIME type sniffed from the head of the content, the Content-Type sent by the client isn't trusted.
+	MimeType() (string, error)
+	// AllowedMimeTypes Restrict the MIME types of the file, Store returns an error if the type isn't allowed: image/*
+	AllowedMimeTypes(mimeTypes ...string) StreamedFile
 }
 
 type (
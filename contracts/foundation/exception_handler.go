@@ -0,0 +1,19 @@
+package foundation
+
+import (
+	"context"
+
+	"github.com/goravel/framework/contracts/http"
+)
+
+//go:generate mockery --name=ExceptionHandler
+type ExceptionHandler interface {
+	// Report Log the error via facades.Log, the errors in the don't report list are skipped.
+	Report(ctx context.Context, err error)
+	// Render Write the error to the response, it's rendered as JSON if the request accepts JSON, otherwise as HTML.
+	Render(ctx http.Context, err error)
+	// DontReport Add errors that shouldn't be reported, they are matched via errors.Is.
+	DontReport(errs ...error) ExceptionHandler
+	// ShouldReport Determine if the error should be reported.
+	ShouldReport(err error) bool
+}
@@ -0,0 +1,78 @@
+// Code generated by mockery v2.12.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	foundation "github.com/goravel/framework/contracts/foundation"
+	http "github.com/goravel/framework/contracts/http"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ExceptionHandler is an autogenerated mock type for the ExceptionHandler type
+type ExceptionHandler struct {
+	mock.Mock
+}
+
+// DontReport provides a mock function with given fields: errs
+func (_m *ExceptionHandler) DontReport(errs ...error) foundation.ExceptionHandler {
+	_va := make([]interface{}, len(errs))
+	for _i := range errs {
+		_va[_i] = errs[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 foundation.ExceptionHandler
+	if rf, ok := ret.Get(0).(func(...error) foundation.ExceptionHandler); ok {
+		r0 = rf(errs...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(foundation.ExceptionHandler)
+		}
+	}
+
+	return r0
+}
+
+// Render provides a mock function with given fields: ctx, err
+func (_m *ExceptionHandler) Render(ctx http.Context, err error) {
+	_m.Called(ctx, err)
+}
+
+// Report provides a mock function with given fields: ctx, err
+func (_m *ExceptionHandler) Report(ctx context.Context, err error) {
+	_m.Called(ctx, err)
+}
+
+// ShouldReport provides a mock function with given fields: err
+func (_m *ExceptionHandler) ShouldReport(err error) bool {
+	ret := _m.Called(err)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(error) bool); ok {
+		r0 = rf(err)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+type NewExceptionHandlerT interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewExceptionHandler creates a new instance of ExceptionHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewExceptionHandler(t NewExceptionHandlerT) *ExceptionHandler {
+	mock := &ExceptionHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
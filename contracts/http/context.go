@@ -4,6 +4,15 @@ import (
 	"context"
 )
 
+// RequestIdKey The key of the request id in the context, it's set by the RequestId middleware.
+const RequestIdKey = "request_id"
+
+// The keys of the tracer and the context of the span in the context, they're set by the Opentracing middleware.
+const (
+	OpentracingTracer = "opentracing_tracer"
+	OpentracingCtx    = "opentracing_ctx"
+)
+
 type Middleware func(Context)
 type HandlerFunc func(Context)
 
@@ -12,6 +21,9 @@ type Context interface {
 	context.Context
 	Context() context.Context
 	WithValue(key string, value interface{})
+	// WithContext Replace the context of the request, it's returned by ctx.Request().Origin().Context() and used by
+	// ctx.Done(), ctx.Err(), etc. of the pending handlers.
+	WithContext(ctx context.Context)
 	Request() Request
 	Response() Response
 }
@@ -0,0 +1,20 @@
+package http
+
+import (
+	"time"
+)
+
+// Cookie The attributes are filled with the cookie config if they are empty, Secure and HttpOnly are overridden only
+// if they are set, so a single cookie can opt out of the config: HttpOnly: &readable (readable := false).
+type Cookie struct {
+	Name     string
+	Value    string
+	Path     string
+	Domain   string
+	Expires  time.Time
+	MaxAge   int
+	Secure   *bool
+	HttpOnly *bool
+	// SameSite lax, strict or none.
+	SameSite string
+}
@@ -131,6 +131,11 @@ func (_m *Context) Value(key interface{}) interface{} {
 	return r0
 }
 
+// WithContext provides a mock function with given fields: ctx
+func (_m *Context) WithContext(ctx context.Context) {
+	_m.Called(ctx)
+}
+
 // WithValue provides a mock function with given fields: key, value
 func (_m *Context) WithValue(key string, value interface{}) {
 	_m.Called(key, value)
@@ -0,0 +1,120 @@
+// Code generated by mockery v2.12.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	http "github.com/goravel/framework/contracts/http"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// Limit is an autogenerated mock type for the Limit type
+type Limit struct {
+	mock.Mock
+}
+
+// By provides a mock function with given fields: key
+func (_m *Limit) By(key string) http.Limit {
+	ret := _m.Called(key)
+
+	var r0 http.Limit
+	if rf, ok := ret.Get(0).(func(string) http.Limit); ok {
+		r0 = rf(key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(http.Limit)
+		}
+	}
+
+	return r0
+}
+
+// GetDecay provides a mock function with given fields:
+func (_m *Limit) GetDecay() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// GetKey provides a mock function with given fields:
+func (_m *Limit) GetKey() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// GetMaxAttempts provides a mock function with given fields:
+func (_m *Limit) GetMaxAttempts() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// GetResponse provides a mock function with given fields:
+func (_m *Limit) GetResponse() func(http.Context) {
+	ret := _m.Called()
+
+	var r0 func(http.Context)
+	if rf, ok := ret.Get(0).(func() func(http.Context)); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(func(http.Context))
+		}
+	}
+
+	return r0
+}
+
+// Response provides a mock function with given fields: callback
+func (_m *Limit) Response(callback func(http.Context)) http.Limit {
+	ret := _m.Called(callback)
+
+	var r0 http.Limit
+	if rf, ok := ret.Get(0).(func(func(http.Context)) http.Limit); ok {
+		r0 = rf(callback)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(http.Limit)
+		}
+	}
+
+	return r0
+}
+
+type NewLimitT interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewLimit creates a new instance of Limit. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewLimit(t NewLimitT) *Limit {
+	mock := &Limit{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
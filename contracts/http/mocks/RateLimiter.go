@@ -0,0 +1,140 @@
+// Code generated by mockery v2.12.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	http "github.com/goravel/framework/contracts/http"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// RateLimiter is an autogenerated mock type for the RateLimiter type
+type RateLimiter struct {
+	mock.Mock
+}
+
+// Attempt provides a mock function with given fields: key, maxAttempts, callback, decay
+func (_m *RateLimiter) Attempt(key string, maxAttempts int, callback func(), decay time.Duration) bool {
+	ret := _m.Called(key, maxAttempts, callback, decay)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string, int, func(), time.Duration) bool); ok {
+		r0 = rf(key, maxAttempts, callback, decay)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Attempts provides a mock function with given fields: key
+func (_m *RateLimiter) Attempts(key string) int {
+	ret := _m.Called(key)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(string) int); ok {
+		r0 = rf(key)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// AvailableIn provides a mock function with given fields: key
+func (_m *RateLimiter) AvailableIn(key string) time.Duration {
+	ret := _m.Called(key)
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func(string) time.Duration); ok {
+		r0 = rf(key)
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// Clear provides a mock function with given fields: key
+func (_m *RateLimiter) Clear(key string) {
+	_m.Called(key)
+}
+
+// For provides a mock function with given fields: name, callback
+func (_m *RateLimiter) For(name string, callback func(http.Context) http.Limit) {
+	_m.Called(name, callback)
+}
+
+// Hit provides a mock function with given fields: key, decay
+func (_m *RateLimiter) Hit(key string, decay time.Duration) int {
+	ret := _m.Called(key, decay)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(string, time.Duration) int); ok {
+		r0 = rf(key, decay)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// Limiter provides a mock function with given fields: name
+func (_m *RateLimiter) Limiter(name string) func(http.Context) http.Limit {
+	ret := _m.Called(name)
+
+	var r0 func(http.Context) http.Limit
+	if rf, ok := ret.Get(0).(func(string) func(http.Context) http.Limit); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(func(http.Context) http.Limit)
+		}
+	}
+
+	return r0
+}
+
+// RemainingAttempts provides a mock function with given fields: key, maxAttempts
+func (_m *RateLimiter) RemainingAttempts(key string, maxAttempts int) int {
+	ret := _m.Called(key, maxAttempts)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(string, int) int); ok {
+		r0 = rf(key, maxAttempts)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// TooManyAttempts provides a mock function with given fields: key, maxAttempts
+func (_m *RateLimiter) TooManyAttempts(key string, maxAttempts int) bool {
+	ret := _m.Called(key, maxAttempts)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string, int) bool); ok {
+		r0 = rf(key, maxAttempts)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+type NewRateLimiterT interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRateLimiter creates a new instance of RateLimiter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRateLimiter(t NewRateLimiterT) *RateLimiter {
+	mock := &RateLimiter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -9,6 +9,10 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	nethttp "net/http"
+
+	session "github.com/goravel/framework/contracts/session"
+
+	validation "github.com/goravel/framework/contracts/validation"
 )
 
 // Request is an autogenerated mock type for the Request type
@@ -16,6 +20,11 @@ type Request struct {
 	mock.Mock
 }
 
+// Abort provides a mock function with given fields:
+func (_m *Request) Abort() {
+	_m.Called()
+}
+
 // AbortWithStatus provides a mock function with given fields: code
 func (_m *Request) AbortWithStatus(code int) {
 	_m.Called(code)
@@ -40,6 +49,27 @@ func (_m *Request) Bind(obj interface{}) error {
 	return r0
 }
 
+// Cookie provides a mock function with given fields: name, defaultValue
+func (_m *Request) Cookie(name string, defaultValue ...string) string {
+	_va := make([]interface{}, len(defaultValue))
+	for _i := range defaultValue {
+		_va[_i] = defaultValue[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, name)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, ...string) string); ok {
+		r0 = rf(name, defaultValue...)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // File provides a mock function with given fields: name
 func (_m *Request) File(name string) (filesystem.File, error) {
 	ret := _m.Called(name)
@@ -63,6 +93,29 @@ func (_m *Request) File(name string) (filesystem.File, error) {
 	return r0, r1
 }
 
+// Files provides a mock function with given fields: name
+func (_m *Request) Files(name string) ([]filesystem.File, error) {
+	ret := _m.Called(name)
+
+	var r0 []filesystem.File
+	if rf, ok := ret.Get(0).(func(string) []filesystem.File); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]filesystem.File)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Form provides a mock function with given fields: key, defaultValue
 func (_m *Request) Form(key string, defaultValue string) string {
 	ret := _m.Called(key, defaultValue)
@@ -228,6 +281,52 @@ func (_m *Request) Response() http.Response {
 	return r0
 }
 
+// Session provides a mock function with given fields:
+func (_m *Request) Session() session.Session {
+	ret := _m.Called()
+
+	var r0 session.Session
+	if rf, ok := ret.Get(0).(func() session.Session); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(session.Session)
+		}
+	}
+
+	return r0
+}
+
+// SetSession provides a mock function with given fields: _a0
+func (_m *Request) SetSession(_a0 session.Session) http.Request {
+	ret := _m.Called(_a0)
+
+	var r0 http.Request
+	if rf, ok := ret.Get(0).(func(session.Session) http.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(http.Request)
+		}
+	}
+
+	return r0
+}
+
+// StreamFiles provides a mock function with given fields: handler
+func (_m *Request) StreamFiles(handler func(string, filesystem.StreamedFile) error) error {
+	ret := _m.Called(handler)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(func(string, filesystem.StreamedFile) error) error); ok {
+		r0 = rf(handler)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Url provides a mock function with given fields:
 func (_m *Request) Url() string {
 	ret := _m.Called()
@@ -242,6 +341,59 @@ func (_m *Request) Url() string {
 	return r0
 }
 
+// Validate provides a mock function with given fields: rules, options
+func (_m *Request) Validate(rules map[string]string, options ...validation.Option) (validation.Validator, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, rules)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 validation.Validator
+	if rf, ok := ret.Get(0).(func(map[string]string, ...validation.Option) validation.Validator); ok {
+		r0 = rf(rules, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(validation.Validator)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(map[string]string, ...validation.Option) error); ok {
+		r1 = rf(rules, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ValidateRequest provides a mock function with given fields: request
+func (_m *Request) ValidateRequest(request http.FormRequest) (validation.Errors, error) {
+	ret := _m.Called(request)
+
+	var r0 validation.Errors
+	if rf, ok := ret.Get(0).(func(http.FormRequest) validation.Errors); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(validation.Errors)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(http.FormRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type NewRequestT interface {
 	mock.TestingT
 	Cleanup(func())
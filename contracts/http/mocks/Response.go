@@ -3,8 +3,13 @@
 package mocks
 
 import (
+	io "io"
+
 	http "github.com/goravel/framework/contracts/http"
+
 	mock "github.com/stretchr/testify/mock"
+
+	nethttp "net/http"
 )
 
 // Response is an autogenerated mock type for the Response type
@@ -12,6 +17,22 @@ type Response struct {
 	mock.Mock
 }
 
+// Cookie provides a mock function with given fields: cookie
+func (_m *Response) Cookie(cookie http.Cookie) http.Response {
+	ret := _m.Called(cookie)
+
+	var r0 http.Response
+	if rf, ok := ret.Get(0).(func(http.Cookie) http.Response); ok {
+		r0 = rf(cookie)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(http.Response)
+		}
+	}
+
+	return r0
+}
+
 // Download provides a mock function with given fields: filepath, filename
 func (_m *Response) Download(filepath string, filename string) {
 	_m.Called(filepath, filename)
@@ -43,6 +64,31 @@ func (_m *Response) Json(code int, obj interface{}) {
 	_m.Called(code, obj)
 }
 
+// Negotiate provides a mock function with given fields: code, obj
+func (_m *Response) Negotiate(code int, obj interface{}) {
+	_m.Called(code, obj)
+}
+
+// ProtoBuf provides a mock function with given fields: code, obj
+func (_m *Response) ProtoBuf(code int, obj interface{}) {
+	_m.Called(code, obj)
+}
+
+// SSE provides a mock function with given fields: events
+func (_m *Response) SSE(events <-chan http.Event) {
+	_m.Called(events)
+}
+
+// SetWriter provides a mock function with given fields: w
+func (_m *Response) SetWriter(w nethttp.ResponseWriter) {
+	_m.Called(w)
+}
+
+// Stream provides a mock function with given fields: step
+func (_m *Response) Stream(step func(io.Writer) bool) {
+	_m.Called(step)
+}
+
 // String provides a mock function with given fields: code, format, values
 func (_m *Response) String(code int, format string, values ...interface{}) {
 	var _ca []interface{}
@@ -67,6 +113,53 @@ func (_m *Response) Success() http.ResponseSuccess {
 	return r0
 }
 
+// View provides a mock function with given fields: view, data
+func (_m *Response) View(view string, data interface{}) {
+	_m.Called(view, data)
+}
+
+// WithoutCookie provides a mock function with given fields: name
+func (_m *Response) WithoutCookie(name string) http.Response {
+	ret := _m.Called(name)
+
+	var r0 http.Response
+	if rf, ok := ret.Get(0).(func(string) http.Response); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(http.Response)
+		}
+	}
+
+	return r0
+}
+
+// Writer provides a mock function with given fields:
+func (_m *Response) Writer() nethttp.ResponseWriter {
+	ret := _m.Called()
+
+	var r0 nethttp.ResponseWriter
+	if rf, ok := ret.Get(0).(func() nethttp.ResponseWriter); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(nethttp.ResponseWriter)
+		}
+	}
+
+	return r0
+}
+
+// Xml provides a mock function with given fields: code, obj
+func (_m *Response) Xml(code int, obj interface{}) {
+	_m.Called(code, obj)
+}
+
+// Yaml provides a mock function with given fields: code, obj
+func (_m *Response) Yaml(code int, obj interface{}) {
+	_m.Called(code, obj)
+}
+
 type NewResponseT interface {
 	mock.TestingT
 	Cleanup(func())
@@ -0,0 +1,127 @@
+// Code generated by mockery v2.12.3. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// WebSocketConnection is an autogenerated mock type for the WebSocketConnection type
+type WebSocketConnection struct {
+	mock.Mock
+}
+
+// Close provides a mock function with given fields: code, reason
+func (_m *WebSocketConnection) Close(code int, reason string) error {
+	ret := _m.Called(code, reason)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, string) error); ok {
+		r0 = rf(code, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Done provides a mock function with given fields:
+func (_m *WebSocketConnection) Done() <-chan struct{} {
+	ret := _m.Called()
+
+	var r0 <-chan struct{}
+	if rf, ok := ret.Get(0).(func() <-chan struct{}); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan struct{})
+		}
+	}
+
+	return r0
+}
+
+// ReadJson provides a mock function with given fields: obj
+func (_m *WebSocketConnection) ReadJson(obj interface{}) error {
+	ret := _m.Called(obj)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(interface{}) error); ok {
+		r0 = rf(obj)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReadMessage provides a mock function with given fields:
+func (_m *WebSocketConnection) ReadMessage() (int, []byte, error) {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 []byte
+	if rf, ok := ret.Get(1).(func() []byte); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]byte)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func() error); ok {
+		r2 = rf()
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// WriteJson provides a mock function with given fields: obj
+func (_m *WebSocketConnection) WriteJson(obj interface{}) error {
+	ret := _m.Called(obj)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(interface{}) error); ok {
+		r0 = rf(obj)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WriteMessage provides a mock function with given fields: messageType, data
+func (_m *WebSocketConnection) WriteMessage(messageType int, data []byte) error {
+	ret := _m.Called(messageType, data)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, []byte) error); ok {
+		r0 = rf(messageType, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type NewWebSocketConnectionT interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewWebSocketConnection creates a new instance of WebSocketConnection. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewWebSocketConnection(t NewWebSocketConnectionT) *WebSocketConnection {
+	mock := &WebSocketConnection{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
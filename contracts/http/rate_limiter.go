@@ -0,0 +1,39 @@
+package http
+
+import (
+	"time"
+)
+
+//go:generate mockery --name=RateLimiter
+type RateLimiter interface {
+	// For Register a named limiter, it's used by middleware.Throttle(name).
+	For(name string, callback func(ctx Context) Limit)
+	// Limiter Get a named limiter, return nil if the limiter doesn't exist.
+	Limiter(name string) func(ctx Context) Limit
+	// Attempt Execute the callback if the key hasn't been attempted too many times, return false if it's limited.
+	Attempt(key string, maxAttempts int, callback func(), decay time.Duration) bool
+	// TooManyAttempts Determine if the key has been attempted too many times.
+	TooManyAttempts(key string, maxAttempts int) bool
+	// Hit Increment the attempts of the key for the given decay time, return the attempts.
+	Hit(key string, decay time.Duration) int
+	// Attempts Get the number of attempts of the key.
+	Attempts(key string) int
+	// RemainingAttempts Get the number of retries left of the key.
+	RemainingAttempts(key string, maxAttempts int) int
+	// AvailableIn Get the time until the key is accessible again.
+	AvailableIn(key string) time.Duration
+	// Clear the attempts of the key.
+	Clear(key string)
+}
+
+//go:generate mockery --name=Limit
+type Limit interface {
+	// By Set the key of the limit, e.g. the user ID or IP, the client IP is used by default.
+	By(key string) Limit
+	// Response Set the callback to render the response when the limit is exceeded.
+	Response(callback func(ctx Context)) Limit
+	GetKey() string
+	GetMaxAttempts() int
+	GetDecay() time.Duration
+	GetResponse() func(ctx Context)
+}
@@ -4,6 +4,8 @@ import (
 	"net/http"
 
 	"github.com/goravel/framework/contracts/filesystem"
+	"github.com/goravel/framework/contracts/session"
+	"github.com/goravel/framework/contracts/validation"
 )
 
 //go:generate mockery --name=Request
@@ -22,9 +24,18 @@ type Request interface {
 	Query(key, defaultValue string) string
 	// Form Retrieve a form string item form the post: /users POST:id=1
 	Form(key, defaultValue string) string
+	// Cookie Retrieve a cookie from the request, it's decrypted unless it's in the cookie.except config.
+	Cookie(name string, defaultValue ...string) string
 	Bind(obj interface{}) error
 	File(name string) (filesystem.File, error)
+	// Files Retrieve the files of a multi-file input: <input type="file" name="photos" multiple>
+	Files(name string) ([]filesystem.File, error)
+	// StreamFiles Read the files of a multipart request one by one from the body without temp copies, the handler is
+	// called with the input name of each file. The body is consumed, so the other inputs can't be retrieved after it.
+	StreamFiles(handler func(name string, file filesystem.StreamedFile) error) error
 
+	// Abort Prevent the pending handlers from being called, the response written by the current handler is kept.
+	Abort()
 	AbortWithStatus(code int)
 	AbortWithStatusJson(code int, jsonObj interface{})
 
@@ -32,10 +43,23 @@ type Request interface {
 	Origin() *http.Request
 	Response() Response
 
-	//Validate(ctx *gin.Context, request FormRequest) []error
+	// Session Get the session of the request, it's nil if the StartSession middleware isn't used.
+	Session() session.Session
+	SetSession(session session.Session) Request
+
+	// Validate the json, form, query and route input of the request with the given rules.
+	Validate(rules map[string]string, options ...validation.Option) (validation.Validator, error)
+	// ValidateRequest Bind the input of the request to a FormRequest and validate it, return nil errors if it passes.
+	ValidateRequest(request FormRequest) (validation.Errors, error)
 }
 
 type FormRequest interface {
-	Messages() map[string]string
+	// Authorize Determine if the user is authorized to make the request.
 	Authorize() bool
+	// Rules Get the validation rules that apply to the request.
+	Rules() map[string]string
+	// Messages Get custom messages for validator errors, keyed by "field.rule" or "rule".
+	Messages() map[string]string
+	// Attributes Get custom attribute names for validator errors.
+	Attributes() map[string]string
 }
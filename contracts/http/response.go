@@ -1,15 +1,49 @@
 package http
 
+import (
+	"io"
+	"net/http"
+)
+
 type Json map[string]interface{}
 
+// Event A Server-Sent Event, the Data is encoded as JSON unless it's a string.
+type Event struct {
+	ID    string
+	Event string
+	Data  interface{}
+	// Retry The reconnection time of the client in milliseconds.
+	Retry int
+}
+
 //go:generate mockery --name=Response
 type Response interface {
 	String(code int, format string, values ...interface{})
 	Json(code int, obj interface{})
+	Xml(code int, obj interface{})
+	Yaml(code int, obj interface{})
+	// ProtoBuf Write the obj in protocol buffers, the obj must be a proto.Message.
+	ProtoBuf(code int, obj interface{})
+	// Negotiate Write the obj in the format accepted by the Accept header of the request: JSON, XML, YAML or protocol buffers.
+	// JSON is used if the Accept header is missing, and 406 is responded if none of the formats is accepted.
+	Negotiate(code int, obj interface{})
 	File(filepath string)
 	Download(filepath, filename string)
+	// View Render the view with the data, the name is the path relative to the view.path config: users/show
+	View(view string, data interface{})
+	// Stream Write the response chunk by chunk, the step is called until it returns false or the client disconnects.
+	Stream(step func(w io.Writer) bool)
+	// SSE Send the events as Server-Sent Events until the channel is closed or the client disconnects.
+	SSE(events <-chan Event)
 	Success() ResponseSuccess
 	Header(key, value string) Response
+	// Cookie Add a cookie to the response, it's encrypted unless it's in the cookie.except config.
+	Cookie(cookie Cookie) Response
+	// WithoutCookie Expire a cookie of the client.
+	WithoutCookie(name string) Response
+	Writer() http.ResponseWriter
+	// SetWriter Replace the writer of the response, it's used to wrap the current writer in middlewares.
+	SetWriter(w http.ResponseWriter)
 }
 
 //go:generate mockery --name=ResponseSuccess
@@ -0,0 +1,33 @@
+package http
+
+// The message types of WebSocket, they are the same as RFC 6455.
+const (
+	WebSocketTextMessage   = 1
+	WebSocketBinaryMessage = 2
+)
+
+// The close codes of WebSocket, they are the same as RFC 6455.
+const (
+	WebSocketCloseNormalClosure   = 1000
+	WebSocketCloseGoingAway       = 1001
+	WebSocketCloseUnsupportedData = 1003
+	WebSocketClosePolicyViolation = 1008
+	WebSocketCloseInternalError   = 1011
+)
+
+// WebSocketHandler The handler of a WebSocket route, the connection is closed after the handler returns.
+type WebSocketHandler func(ctx Context, conn WebSocketConnection)
+
+//go:generate mockery --name=WebSocketConnection
+type WebSocketConnection interface {
+	// ReadJson Read the next message as JSON, io.EOF is returned if the client closes the connection normally.
+	ReadJson(obj interface{}) error
+	WriteJson(obj interface{}) error
+	// ReadMessage Read the next message, io.EOF is returned if the client closes the connection normally.
+	ReadMessage() (messageType int, data []byte, err error)
+	WriteMessage(messageType int, data []byte) error
+	// Close Send a close message with the code and the reason, then close the connection.
+	Close(code int, reason string) error
+	// Done It's closed when the connection is closed.
+	Done() <-chan struct{}
+}
@@ -0,0 +1,85 @@
+package httpclient
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+//go:generate mockery --name=Http
+type Http interface {
+	Request
+	// Fake Respond the requests with the fake responses instead of sending them, the keys are the patterns of the
+	// urls, * matches any characters: {"github.com/*": {Status: 200, Body: map[string]string{...}}, "*": {Status: 404}}.
+	// The requests that don't match any pattern are responded with an empty 200 response.
+	Fake(responses map[string]FakeResponse) Http
+	// Unfake Stop responding the fake responses and drop the recorded requests, the requests are sent again.
+	Unfake() Http
+	// Recorded Get the requests sent in the fake mode.
+	Recorded() []RecordedRequest
+	AssertSent(callback func(request RecordedRequest) bool) bool
+	AssertNotSent(callback func(request RecordedRequest) bool) bool
+	AssertSentCount(count int) bool
+	AssertNothingSent() bool
+}
+
+//go:generate mockery --name=Request
+type Request interface {
+	// WithContext Send the request with the context, the trace of the Opentracing middleware is propagated if the
+	// context is a http.Context.
+	WithContext(ctx context.Context) Request
+	BaseUrl(url string) Request
+	WithHeader(key, value string) Request
+	WithHeaders(headers map[string]string) Request
+	// WithToken Add the Authorization header, the type is Bearer by default.
+	WithToken(token string, tokenType ...string) Request
+	WithBasicAuth(username, password string) Request
+	WithQuery(key, value string) Request
+	// AsForm Encode the body as application/x-www-form-urlencoded, it's encoded as JSON by default.
+	AsForm() Request
+	Accept(contentType string) Request
+	AcceptJson() Request
+	Timeout(timeout time.Duration) Request
+	// Retry Retry the request if it fails, the sleep is doubled after every attempt. The request is retried on
+	// connection errors and 5xx responses unless the condition is given.
+	Retry(times int, sleep time.Duration, when ...func(response Response, err error) bool) Request
+
+	Get(url string) (Response, error)
+	Head(url string) (Response, error)
+	// Post Send the request with the body, the string, []byte and io.Reader bodies are sent as they are.
+	Post(url string, body interface{}) (Response, error)
+	Put(url string, body interface{}) (Response, error)
+	Patch(url string, body interface{}) (Response, error)
+	Delete(url string, body ...interface{}) (Response, error)
+	Send(method, url string, body interface{}) (Response, error)
+}
+
+//go:generate mockery --name=Response
+type Response interface {
+	Status() int
+	Header(key string) string
+	Headers() http.Header
+	Body() []byte
+	String() string
+	// Json Decode the JSON body into the obj.
+	Json(obj interface{}) error
+	Successful() bool
+	Failed() bool
+	ClientError() bool
+	ServerError() bool
+}
+
+type FakeResponse struct {
+	Status int
+	// Body The string and []byte bodies are sent as they are, the others are encoded as JSON.
+	Body    interface{}
+	Headers map[string]string
+}
+
+type RecordedRequest struct {
+	Method   string
+	Url      string
+	Headers  http.Header
+	Body     []byte
+	Response Response
+}
@@ -0,0 +1,506 @@
+// Code generated by mockery v2.12.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	httpclient "github.com/goravel/framework/contracts/httpclient"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// Http is an autogenerated mock type for the Http type
+type Http struct {
+	mock.Mock
+}
+
+// Accept provides a mock function with given fields: contentType
+func (_m *Http) Accept(contentType string) httpclient.Request {
+	ret := _m.Called(contentType)
+
+	var r0 httpclient.Request
+	if rf, ok := ret.Get(0).(func(string) httpclient.Request); ok {
+		r0 = rf(contentType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(httpclient.Request)
+		}
+	}
+
+	return r0
+}
+
+// AcceptJson provides a mock function with given fields:
+func (_m *Http) AcceptJson() httpclient.Request {
+	ret := _m.Called()
+
+	var r0 httpclient.Request
+	if rf, ok := ret.Get(0).(func() httpclient.Request); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(httpclient.Request)
+		}
+	}
+
+	return r0
+}
+
+// AsForm provides a mock function with given fields:
+func (_m *Http) AsForm() httpclient.Request {
+	ret := _m.Called()
+
+	var r0 httpclient.Request
+	if rf, ok := ret.Get(0).(func() httpclient.Request); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(httpclient.Request)
+		}
+	}
+
+	return r0
+}
+
+// AssertNotSent provides a mock function with given fields: callback
+func (_m *Http) AssertNotSent(callback func(httpclient.RecordedRequest) bool) bool {
+	ret := _m.Called(callback)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(func(httpclient.RecordedRequest) bool) bool); ok {
+		r0 = rf(callback)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// AssertNothingSent provides a mock function with given fields:
+func (_m *Http) AssertNothingSent() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// AssertSent provides a mock function with given fields: callback
+func (_m *Http) AssertSent(callback func(httpclient.RecordedRequest) bool) bool {
+	ret := _m.Called(callback)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(func(httpclient.RecordedRequest) bool) bool); ok {
+		r0 = rf(callback)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// AssertSentCount provides a mock function with given fields: count
+func (_m *Http) AssertSentCount(count int) bool {
+	ret := _m.Called(count)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(int) bool); ok {
+		r0 = rf(count)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// BaseUrl provides a mock function with given fields: url
+func (_m *Http) BaseUrl(url string) httpclient.Request {
+	ret := _m.Called(url)
+
+	var r0 httpclient.Request
+	if rf, ok := ret.Get(0).(func(string) httpclient.Request); ok {
+		r0 = rf(url)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(httpclient.Request)
+		}
+	}
+
+	return r0
+}
+
+// Delete provides a mock function with given fields: url, body
+func (_m *Http) Delete(url string, body ...interface{}) (httpclient.Response, error) {
+	var _ca []interface{}
+	_ca = append(_ca, url)
+	_ca = append(_ca, body...)
+	ret := _m.Called(_ca...)
+
+	var r0 httpclient.Response
+	if rf, ok := ret.Get(0).(func(string, ...interface{}) httpclient.Response); ok {
+		r0 = rf(url, body...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(httpclient.Response)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, ...interface{}) error); ok {
+		r1 = rf(url, body...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Fake provides a mock function with given fields: responses
+func (_m *Http) Fake(responses map[string]httpclient.FakeResponse) httpclient.Http {
+	ret := _m.Called(responses)
+
+	var r0 httpclient.Http
+	if rf, ok := ret.Get(0).(func(map[string]httpclient.FakeResponse) httpclient.Http); ok {
+		r0 = rf(responses)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(httpclient.Http)
+		}
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: url
+func (_m *Http) Get(url string) (httpclient.Response, error) {
+	ret := _m.Called(url)
+
+	var r0 httpclient.Response
+	if rf, ok := ret.Get(0).(func(string) httpclient.Response); ok {
+		r0 = rf(url)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(httpclient.Response)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(url)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Head provides a mock function with given fields: url
+func (_m *Http) Head(url string) (httpclient.Response, error) {
+	ret := _m.Called(url)
+
+	var r0 httpclient.Response
+	if rf, ok := ret.Get(0).(func(string) httpclient.Response); ok {
+		r0 = rf(url)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(httpclient.Response)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(url)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Patch provides a mock function with given fields: url, body
+func (_m *Http) Patch(url string, body interface{}) (httpclient.Response, error) {
+	ret := _m.Called(url, body)
+
+	var r0 httpclient.Response
+	if rf, ok := ret.Get(0).(func(string, interface{}) httpclient.Response); ok {
+		r0 = rf(url, body)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(httpclient.Response)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, interface{}) error); ok {
+		r1 = rf(url, body)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Post provides a mock function with given fields: url, body
+func (_m *Http) Post(url string, body interface{}) (httpclient.Response, error) {
+	ret := _m.Called(url, body)
+
+	var r0 httpclient.Response
+	if rf, ok := ret.Get(0).(func(string, interface{}) httpclient.Response); ok {
+		r0 = rf(url, body)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(httpclient.Response)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, interface{}) error); ok {
+		r1 = rf(url, body)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Put provides a mock function with given fields: url, body
+func (_m *Http) Put(url string, body interface{}) (httpclient.Response, error) {
+	ret := _m.Called(url, body)
+
+	var r0 httpclient.Response
+	if rf, ok := ret.Get(0).(func(string, interface{}) httpclient.Response); ok {
+		r0 = rf(url, body)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(httpclient.Response)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, interface{}) error); ok {
+		r1 = rf(url, body)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Recorded provides a mock function with given fields:
+func (_m *Http) Recorded() []httpclient.RecordedRequest {
+	ret := _m.Called()
+
+	var r0 []httpclient.RecordedRequest
+	if rf, ok := ret.Get(0).(func() []httpclient.RecordedRequest); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]httpclient.RecordedRequest)
+		}
+	}
+
+	return r0
+}
+
+// Retry provides a mock function with given fields: times, sleep, when
+func (_m *Http) Retry(times int, sleep time.Duration, when ...func(httpclient.Response, error) bool) httpclient.Request {
+	_va := make([]interface{}, len(when))
+	for _i := range when {
+		_va[_i] = when[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, times, sleep)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 httpclient.Request
+	if rf, ok := ret.Get(0).(func(int, time.Duration, ...func(httpclient.Response, error) bool) httpclient.Request); ok {
+		r0 = rf(times, sleep, when...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(httpclient.Request)
+		}
+	}
+
+	return r0
+}
+
+// Send provides a mock function with given fields: method, url, body
+func (_m *Http) Send(method string, url string, body interface{}) (httpclient.Response, error) {
+	ret := _m.Called(method, url, body)
+
+	var r0 httpclient.Response
+	if rf, ok := ret.Get(0).(func(string, string, interface{}) httpclient.Response); ok {
+		r0 = rf(method, url, body)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(httpclient.Response)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, interface{}) error); ok {
+		r1 = rf(method, url, body)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Timeout provides a mock function with given fields: timeout
+func (_m *Http) Timeout(timeout time.Duration) httpclient.Request {
+	ret := _m.Called(timeout)
+
+	var r0 httpclient.Request
+	if rf, ok := ret.Get(0).(func(time.Duration) httpclient.Request); ok {
+		r0 = rf(timeout)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(httpclient.Request)
+		}
+	}
+
+	return r0
+}
+
+// Unfake provides a mock function with given fields:
+func (_m *Http) Unfake() httpclient.Http {
+	ret := _m.Called()
+
+	var r0 httpclient.Http
+	if rf, ok := ret.Get(0).(func() httpclient.Http); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(httpclient.Http)
+		}
+	}
+
+	return r0
+}
+
+// WithBasicAuth provides a mock function with given fields: username, password
+func (_m *Http) WithBasicAuth(username string, password string) httpclient.Request {
+	ret := _m.Called(username, password)
+
+	var r0 httpclient.Request
+	if rf, ok := ret.Get(0).(func(string, string) httpclient.Request); ok {
+		r0 = rf(username, password)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(httpclient.Request)
+		}
+	}
+
+	return r0
+}
+
+// WithContext provides a mock function with given fields: ctx
+func (_m *Http) WithContext(ctx context.Context) httpclient.Request {
+	ret := _m.Called(ctx)
+
+	var r0 httpclient.Request
+	if rf, ok := ret.Get(0).(func(context.Context) httpclient.Request); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(httpclient.Request)
+		}
+	}
+
+	return r0
+}
+
+// WithHeader provides a mock function with given fields: key, value
+func (_m *Http) WithHeader(key string, value string) httpclient.Request {
+	ret := _m.Called(key, value)
+
+	var r0 httpclient.Request
+	if rf, ok := ret.Get(0).(func(string, string) httpclient.Request); ok {
+		r0 = rf(key, value)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(httpclient.Request)
+		}
+	}
+
+	return r0
+}
+
+// WithHeaders provides a mock function with given fields: headers
+func (_m *Http) WithHeaders(headers map[string]string) httpclient.Request {
+	ret := _m.Called(headers)
+
+	var r0 httpclient.Request
+	if rf, ok := ret.Get(0).(func(map[string]string) httpclient.Request); ok {
+		r0 = rf(headers)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(httpclient.Request)
+		}
+	}
+
+	return r0
+}
+
+// WithQuery provides a mock function with given fields: key, value
+func (_m *Http) WithQuery(key string, value string) httpclient.Request {
+	ret := _m.Called(key, value)
+
+	var r0 httpclient.Request
+	if rf, ok := ret.Get(0).(func(string, string) httpclient.Request); ok {
+		r0 = rf(key, value)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(httpclient.Request)
+		}
+	}
+
+	return r0
+}
+
+// WithToken provides a mock function with given fields: token, tokenType
+func (_m *Http) WithToken(token string, tokenType ...string) httpclient.Request {
+	_va := make([]interface{}, len(tokenType))
+	for _i := range tokenType {
+		_va[_i] = tokenType[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, token)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 httpclient.Request
+	if rf, ok := ret.Get(0).(func(string, ...string) httpclient.Request); ok {
+		r0 = rf(token, tokenType...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(httpclient.Request)
+		}
+	}
+
+	return r0
+}
+
+type NewHttpT interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewHttp creates a new instance of Http. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewHttp(t NewHttpT) *Http {
+	mock := &Http{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -29,6 +29,8 @@ type Log interface {
 
 //go:generate mockery --name=Writer
 type Writer interface {
+	// WithFields Add the structured fields to the entries, they are written as JSON after the message.
+	WithFields(fields map[string]interface{}) Writer
 	Debug(args ...interface{})
 	Debugf(format string, args ...interface{})
 	Info(args ...interface{})
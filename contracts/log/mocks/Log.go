@@ -120,6 +120,22 @@ func (_m *Log) WithContext(ctx context.Context) log.Writer {
 	return r0
 }
 
+// WithFields provides a mock function with given fields: fields
+func (_m *Log) WithFields(fields map[string]interface{}) log.Writer {
+	ret := _m.Called(fields)
+
+	var r0 log.Writer
+	if rf, ok := ret.Get(0).(func(map[string]interface{}) log.Writer); ok {
+		r0 = rf(fields)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(log.Writer)
+		}
+	}
+
+	return r0
+}
+
 type NewLogT interface {
 	mock.TestingT
 	Cleanup(func())
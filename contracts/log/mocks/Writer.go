@@ -2,7 +2,10 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	log "github.com/goravel/framework/contracts/log"
+	mock "github.com/stretchr/testify/mock"
+)
 
 // Writer is an autogenerated mock type for the Writer type
 type Writer struct {
@@ -99,6 +102,22 @@ func (_m *Writer) Warningf(format string, args ...interface{}) {
 	_m.Called(_ca...)
 }
 
+// WithFields provides a mock function with given fields: fields
+func (_m *Writer) WithFields(fields map[string]interface{}) log.Writer {
+	ret := _m.Called(fields)
+
+	var r0 log.Writer
+	if rf, ok := ret.Get(0).(func(map[string]interface{}) log.Writer); ok {
+		r0 = rf(fields)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(log.Writer)
+		}
+	}
+
+	return r0
+}
+
 type NewWriterT interface {
 	mock.TestingT
 	Cleanup(func())
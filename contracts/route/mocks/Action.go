@@ -0,0 +1,146 @@
+// Code generated by mockery v2.12.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	route "github.com/goravel/framework/contracts/route"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Action is an autogenerated mock type for the Action type
+type Action struct {
+	mock.Mock
+}
+
+// Deprecated provides a mock function with given fields:
+func (_m *Action) Deprecated() route.Action {
+	ret := _m.Called()
+
+	var r0 route.Action
+	if rf, ok := ret.Get(0).(func() route.Action); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(route.Action)
+		}
+	}
+
+	return r0
+}
+
+// Description provides a mock function with given fields: description
+func (_m *Action) Description(description string) route.Action {
+	ret := _m.Called(description)
+
+	var r0 route.Action
+	if rf, ok := ret.Get(0).(func(string) route.Action); ok {
+		r0 = rf(description)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(route.Action)
+		}
+	}
+
+	return r0
+}
+
+// Name provides a mock function with given fields: name
+func (_m *Action) Name(name string) route.Action {
+	ret := _m.Called(name)
+
+	var r0 route.Action
+	if rf, ok := ret.Get(0).(func(string) route.Action); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(route.Action)
+		}
+	}
+
+	return r0
+}
+
+// Request provides a mock function with given fields: request
+func (_m *Action) Request(request interface{}) route.Action {
+	ret := _m.Called(request)
+
+	var r0 route.Action
+	if rf, ok := ret.Get(0).(func(interface{}) route.Action); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(route.Action)
+		}
+	}
+
+	return r0
+}
+
+// Response provides a mock function with given fields: code, response
+func (_m *Action) Response(code int, response interface{}) route.Action {
+	ret := _m.Called(code, response)
+
+	var r0 route.Action
+	if rf, ok := ret.Get(0).(func(int, interface{}) route.Action); ok {
+		r0 = rf(code, response)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(route.Action)
+		}
+	}
+
+	return r0
+}
+
+// Summary provides a mock function with given fields: summary
+func (_m *Action) Summary(summary string) route.Action {
+	ret := _m.Called(summary)
+
+	var r0 route.Action
+	if rf, ok := ret.Get(0).(func(string) route.Action); ok {
+		r0 = rf(summary)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(route.Action)
+		}
+	}
+
+	return r0
+}
+
+// Tags provides a mock function with given fields: tags
+func (_m *Action) Tags(tags ...string) route.Action {
+	_va := make([]interface{}, len(tags))
+	for _i := range tags {
+		_va[_i] = tags[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 route.Action
+	if rf, ok := ret.Get(0).(func(...string) route.Action); ok {
+		r0 = rf(tags...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(route.Action)
+		}
+	}
+
+	return r0
+}
+
+type NewActionT interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewAction creates a new instance of Action. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewAction(t NewActionT) *Action {
+	mock := &Action{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
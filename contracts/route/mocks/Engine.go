@@ -3,12 +3,16 @@
 package mocks
 
 import (
+	context "context"
+
 	http "github.com/goravel/framework/contracts/http"
 	mock "github.com/stretchr/testify/mock"
 
 	nethttp "net/http"
 
 	route "github.com/goravel/framework/contracts/route"
+
+	time "time"
 )
 
 // Engine is an autogenerated mock type for the Engine type
@@ -17,18 +21,77 @@ type Engine struct {
 }
 
 // Any provides a mock function with given fields: _a0, _a1
-func (_m *Engine) Any(_a0 string, _a1 http.HandlerFunc) {
-	_m.Called(_a0, _a1)
+func (_m *Engine) Any(_a0 string, _a1 http.HandlerFunc) route.Action {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 route.Action
+	if rf, ok := ret.Get(0).(func(string, http.HandlerFunc) route.Action); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(route.Action)
+		}
+	}
+
+	return r0
+}
+
+// ApiResource provides a mock function with given fields: path, controller
+func (_m *Engine) ApiResource(path string, controller route.ApiResourceController) {
+	_m.Called(path, controller)
 }
 
 // Delete provides a mock function with given fields: _a0, _a1
-func (_m *Engine) Delete(_a0 string, _a1 http.HandlerFunc) {
-	_m.Called(_a0, _a1)
+func (_m *Engine) Delete(_a0 string, _a1 http.HandlerFunc) route.Action {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 route.Action
+	if rf, ok := ret.Get(0).(func(string, http.HandlerFunc) route.Action); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(route.Action)
+		}
+	}
+
+	return r0
+}
+
+// Domain provides a mock function with given fields: domain
+func (_m *Engine) Domain(domain string) route.Route {
+	ret := _m.Called(domain)
+
+	var r0 route.Route
+	if rf, ok := ret.Get(0).(func(string) route.Route); ok {
+		r0 = rf(domain)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(route.Route)
+		}
+	}
+
+	return r0
+}
+
+// Fallback provides a mock function with given fields: handler
+func (_m *Engine) Fallback(handler http.HandlerFunc) {
+	_m.Called(handler)
 }
 
 // Get provides a mock function with given fields: _a0, _a1
-func (_m *Engine) Get(_a0 string, _a1 http.HandlerFunc) {
-	_m.Called(_a0, _a1)
+func (_m *Engine) Get(_a0 string, _a1 http.HandlerFunc) route.Action {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 route.Action
+	if rf, ok := ret.Get(0).(func(string, http.HandlerFunc) route.Action); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(route.Action)
+		}
+	}
+
+	return r0
 }
 
 // GlobalMiddleware provides a mock function with given fields: _a0
@@ -47,6 +110,11 @@ func (_m *Engine) Group(_a0 route.GroupFunc) {
 	_m.Called(_a0)
 }
 
+// MethodNotAllowed provides a mock function with given fields: handler
+func (_m *Engine) MethodNotAllowed(handler http.HandlerFunc) {
+	_m.Called(handler)
+}
+
 // Middleware provides a mock function with given fields: _a0
 func (_m *Engine) Middleware(_a0 ...http.Middleware) route.Route {
 	_va := make([]interface{}, len(_a0))
@@ -70,18 +138,51 @@ func (_m *Engine) Middleware(_a0 ...http.Middleware) route.Route {
 }
 
 // Options provides a mock function with given fields: _a0, _a1
-func (_m *Engine) Options(_a0 string, _a1 http.HandlerFunc) {
-	_m.Called(_a0, _a1)
+func (_m *Engine) Options(_a0 string, _a1 http.HandlerFunc) route.Action {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 route.Action
+	if rf, ok := ret.Get(0).(func(string, http.HandlerFunc) route.Action); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(route.Action)
+		}
+	}
+
+	return r0
 }
 
 // Patch provides a mock function with given fields: _a0, _a1
-func (_m *Engine) Patch(_a0 string, _a1 http.HandlerFunc) {
-	_m.Called(_a0, _a1)
+func (_m *Engine) Patch(_a0 string, _a1 http.HandlerFunc) route.Action {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 route.Action
+	if rf, ok := ret.Get(0).(func(string, http.HandlerFunc) route.Action); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(route.Action)
+		}
+	}
+
+	return r0
 }
 
 // Post provides a mock function with given fields: _a0, _a1
-func (_m *Engine) Post(_a0 string, _a1 http.HandlerFunc) {
-	_m.Called(_a0, _a1)
+func (_m *Engine) Post(_a0 string, _a1 http.HandlerFunc) route.Action {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 route.Action
+	if rf, ok := ret.Get(0).(func(string, http.HandlerFunc) route.Action); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(route.Action)
+		}
+	}
+
+	return r0
 }
 
 // Prefix provides a mock function with given fields: addr
@@ -101,8 +202,40 @@ func (_m *Engine) Prefix(addr string) route.Route {
 }
 
 // Put provides a mock function with given fields: _a0, _a1
-func (_m *Engine) Put(_a0 string, _a1 http.HandlerFunc) {
-	_m.Called(_a0, _a1)
+func (_m *Engine) Put(_a0 string, _a1 http.HandlerFunc) route.Action {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 route.Action
+	if rf, ok := ret.Get(0).(func(string, http.HandlerFunc) route.Action); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(route.Action)
+		}
+	}
+
+	return r0
+}
+
+// Resource provides a mock function with given fields: path, controller
+func (_m *Engine) Resource(path string, controller route.ResourceController) {
+	_m.Called(path, controller)
+}
+
+// Routes provides a mock function with given fields:
+func (_m *Engine) Routes() []route.Info {
+	ret := _m.Called()
+
+	var r0 []route.Info
+	if rf, ok := ret.Get(0).(func() []route.Info); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]route.Info)
+		}
+	}
+
+	return r0
 }
 
 // Run provides a mock function with given fields: addr
@@ -119,11 +252,60 @@ func (_m *Engine) Run(addr string) error {
 	return r0
 }
 
+// RunTLS provides a mock function with given fields: addr, certFile, keyFile
+func (_m *Engine) RunTLS(addr string, certFile string, keyFile string) error {
+	ret := _m.Called(addr, certFile, keyFile)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string) error); ok {
+		r0 = rf(addr, certFile, keyFile)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ServeHTTP provides a mock function with given fields: w, req
 func (_m *Engine) ServeHTTP(w nethttp.ResponseWriter, req *nethttp.Request) {
 	_m.Called(w, req)
 }
 
+// Shutdown provides a mock function with given fields: ctx
+func (_m *Engine) Shutdown(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Signed provides a mock function with given fields: name, params
+func (_m *Engine) Signed(name string, params map[string]interface{}) (string, error) {
+	ret := _m.Called(name, params)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, map[string]interface{}) string); ok {
+		r0 = rf(name, params)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, map[string]interface{}) error); ok {
+		r1 = rf(name, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Static provides a mock function with given fields: _a0, _a1
 func (_m *Engine) Static(_a0 string, _a1 string) {
 	_m.Called(_a0, _a1)
@@ -139,6 +321,64 @@ func (_m *Engine) StaticFile(_a0 string, _a1 string) {
 	_m.Called(_a0, _a1)
 }
 
+// TemporarySigned provides a mock function with given fields: name, expiration, params
+func (_m *Engine) TemporarySigned(name string, expiration time.Time, params map[string]interface{}) (string, error) {
+	ret := _m.Called(name, expiration, params)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, time.Time, map[string]interface{}) string); ok {
+		r0 = rf(name, expiration, params)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, time.Time, map[string]interface{}) error); ok {
+		r1 = rf(name, expiration, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Url provides a mock function with given fields: name, params
+func (_m *Engine) Url(name string, params map[string]interface{}) (string, error) {
+	ret := _m.Called(name, params)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, map[string]interface{}) string); ok {
+		r0 = rf(name, params)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, map[string]interface{}) error); ok {
+		r1 = rf(name, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebSocket provides a mock function with given fields: _a0, _a1
+func (_m *Engine) WebSocket(_a0 string, _a1 http.WebSocketHandler) route.Action {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 route.Action
+	if rf, ok := ret.Get(0).(func(string, http.WebSocketHandler) route.Action); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(route.Action)
+		}
+	}
+
+	return r0
+}
+
 type NewEngineT interface {
 	mock.TestingT
 	Cleanup(func())
@@ -9,6 +9,8 @@ import (
 	nethttp "net/http"
 
 	route "github.com/goravel/framework/contracts/route"
+
+	time "time"
 )
 
 // Route is an autogenerated mock type for the Route type
@@ -17,18 +19,72 @@ type Route struct {
 }
 
 // Any provides a mock function with given fields: _a0, _a1
-func (_m *Route) Any(_a0 string, _a1 http.HandlerFunc) {
-	_m.Called(_a0, _a1)
+func (_m *Route) Any(_a0 string, _a1 http.HandlerFunc) route.Action {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 route.Action
+	if rf, ok := ret.Get(0).(func(string, http.HandlerFunc) route.Action); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(route.Action)
+		}
+	}
+
+	return r0
+}
+
+// ApiResource provides a mock function with given fields: path, controller
+func (_m *Route) ApiResource(path string, controller route.ApiResourceController) {
+	_m.Called(path, controller)
 }
 
 // Delete provides a mock function with given fields: _a0, _a1
-func (_m *Route) Delete(_a0 string, _a1 http.HandlerFunc) {
-	_m.Called(_a0, _a1)
+func (_m *Route) Delete(_a0 string, _a1 http.HandlerFunc) route.Action {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 route.Action
+	if rf, ok := ret.Get(0).(func(string, http.HandlerFunc) route.Action); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(route.Action)
+		}
+	}
+
+	return r0
+}
+
+// Domain provides a mock function with given fields: domain
+func (_m *Route) Domain(domain string) route.Route {
+	ret := _m.Called(domain)
+
+	var r0 route.Route
+	if rf, ok := ret.Get(0).(func(string) route.Route); ok {
+		r0 = rf(domain)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(route.Route)
+		}
+	}
+
+	return r0
 }
 
 // Get provides a mock function with given fields: _a0, _a1
-func (_m *Route) Get(_a0 string, _a1 http.HandlerFunc) {
-	_m.Called(_a0, _a1)
+func (_m *Route) Get(_a0 string, _a1 http.HandlerFunc) route.Action {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 route.Action
+	if rf, ok := ret.Get(0).(func(string, http.HandlerFunc) route.Action); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(route.Action)
+		}
+	}
+
+	return r0
 }
 
 // Group provides a mock function with given fields: _a0
@@ -59,18 +115,51 @@ func (_m *Route) Middleware(_a0 ...http.Middleware) route.Route {
 }
 
 // Options provides a mock function with given fields: _a0, _a1
-func (_m *Route) Options(_a0 string, _a1 http.HandlerFunc) {
-	_m.Called(_a0, _a1)
+func (_m *Route) Options(_a0 string, _a1 http.HandlerFunc) route.Action {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 route.Action
+	if rf, ok := ret.Get(0).(func(string, http.HandlerFunc) route.Action); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(route.Action)
+		}
+	}
+
+	return r0
 }
 
 // Patch provides a mock function with given fields: _a0, _a1
-func (_m *Route) Patch(_a0 string, _a1 http.HandlerFunc) {
-	_m.Called(_a0, _a1)
+func (_m *Route) Patch(_a0 string, _a1 http.HandlerFunc) route.Action {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 route.Action
+	if rf, ok := ret.Get(0).(func(string, http.HandlerFunc) route.Action); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(route.Action)
+		}
+	}
+
+	return r0
 }
 
 // Post provides a mock function with given fields: _a0, _a1
-func (_m *Route) Post(_a0 string, _a1 http.HandlerFunc) {
-	_m.Called(_a0, _a1)
+func (_m *Route) Post(_a0 string, _a1 http.HandlerFunc) route.Action {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 route.Action
+	if rf, ok := ret.Get(0).(func(string, http.HandlerFunc) route.Action); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(route.Action)
+		}
+	}
+
+	return r0
 }
 
 // Prefix provides a mock function with given fields: addr
@@ -90,8 +179,45 @@ func (_m *Route) Prefix(addr string) route.Route {
 }
 
 // Put provides a mock function with given fields: _a0, _a1
-func (_m *Route) Put(_a0 string, _a1 http.HandlerFunc) {
-	_m.Called(_a0, _a1)
+func (_m *Route) Put(_a0 string, _a1 http.HandlerFunc) route.Action {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 route.Action
+	if rf, ok := ret.Get(0).(func(string, http.HandlerFunc) route.Action); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(route.Action)
+		}
+	}
+
+	return r0
+}
+
+// Resource provides a mock function with given fields: path, controller
+func (_m *Route) Resource(path string, controller route.ResourceController) {
+	_m.Called(path, controller)
+}
+
+// Signed provides a mock function with given fields: name, params
+func (_m *Route) Signed(name string, params map[string]interface{}) (string, error) {
+	ret := _m.Called(name, params)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, map[string]interface{}) string); ok {
+		r0 = rf(name, params)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, map[string]interface{}) error); ok {
+		r1 = rf(name, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // Static provides a mock function with given fields: _a0, _a1
@@ -109,6 +235,64 @@ func (_m *Route) StaticFile(_a0 string, _a1 string) {
 	_m.Called(_a0, _a1)
 }
 
+// TemporarySigned provides a mock function with given fields: name, expiration, params
+func (_m *Route) TemporarySigned(name string, expiration time.Time, params map[string]interface{}) (string, error) {
+	ret := _m.Called(name, expiration, params)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, time.Time, map[string]interface{}) string); ok {
+		r0 = rf(name, expiration, params)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, time.Time, map[string]interface{}) error); ok {
+		r1 = rf(name, expiration, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Url provides a mock function with given fields: name, params
+func (_m *Route) Url(name string, params map[string]interface{}) (string, error) {
+	ret := _m.Called(name, params)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, map[string]interface{}) string); ok {
+		r0 = rf(name, params)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, map[string]interface{}) error); ok {
+		r1 = rf(name, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebSocket provides a mock function with given fields: _a0, _a1
+func (_m *Route) WebSocket(_a0 string, _a1 http.WebSocketHandler) route.Action {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 route.Action
+	if rf, ok := ret.Get(0).(func(string, http.WebSocketHandler) route.Action); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(route.Action)
+		}
+	}
+
+	return r0
+}
+
 type NewRouteT interface {
 	mock.TestingT
 	Cleanup(func())
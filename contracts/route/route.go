@@ -1,36 +1,134 @@
 package route
 
 import (
+	"context"
 	"net/http"
+	"time"
 
 	httpcontract "github.com/goravel/framework/contracts/http"
 )
 
 type GroupFunc func(routes Route)
 
+// Info The information of a registered route.
+type Info struct {
+	Method string `json:"method"`
+	// Domain The domain pattern of the route, it's empty if the route matches any host: {tenant}.example.com
+	Domain string `json:"domain,omitempty"`
+	// Path The full path of the route, uses the {param} syntax: /users/{id}
+	Path        string   `json:"path"`
+	Name        string   `json:"name"`
+	Handler     string   `json:"handler"`
+	Middlewares []string `json:"middlewares"`
+	// Operation The OpenAPI annotation of the route, it's nil if the route isn't annotated.
+	Operation *Operation `json:"-"`
+}
+
+// Operation The OpenAPI annotation of a route, the request and responses are struct values used to generate schemas.
+type Operation struct {
+	Summary     string
+	Description string
+	Tags        []string
+	Deprecated  bool
+	// Request The body of the request, or the query of GET, HEAD and DELETE requests. The validation rules
+	// are mapped into the schema if it implements http.FormRequest.
+	Request   interface{}
+	Responses map[int]interface{}
+}
+
 //go:generate mockery --name=Engine
 type Engine interface {
 	Route
 	Run(addr string) error
+	// RunTLS Start serving HTTPS, HTTP/2 is supported automatically.
+	RunTLS(addr, certFile, keyFile string) error
+	// Shutdown Gracefully shutdown the server, in-flight requests are drained until ctx is done.
+	Shutdown(ctx context.Context) error
 	ServeHTTP(w http.ResponseWriter, req *http.Request)
 	GlobalMiddleware(...httpcontract.Middleware)
+	// Fallback Set the handler of the requests which don't match any route, it runs after the global middlewares.
+	// The default handler renders a 404 error via facades.ExceptionHandler.
+	Fallback(handler httpcontract.HandlerFunc)
+	// MethodNotAllowed Set the handler of the requests whose path matches a route but the method doesn't, the Allow
+	// header is set before it runs after the global middlewares. The default handler renders a 405 error via facades.ExceptionHandler.
+	MethodNotAllowed(handler httpcontract.HandlerFunc)
+	// Routes Get the registered routes.
+	Routes() []Info
 }
 
 //go:generate mockery --name=Route
 type Route interface {
 	Group(GroupFunc)
 	Prefix(addr string) Route
+	// Domain Set the domain of the routes, the parameters of the host can be got via Request.Input: {tenant}.example.com
+	// The routes of the domain are matched before the routes without domain.
+	Domain(domain string) Route
 	Middleware(...httpcontract.Middleware) Route
 
-	Any(string, httpcontract.HandlerFunc)
-	Get(string, httpcontract.HandlerFunc)
-	Post(string, httpcontract.HandlerFunc)
-	Delete(string, httpcontract.HandlerFunc)
-	Patch(string, httpcontract.HandlerFunc)
-	Put(string, httpcontract.HandlerFunc)
-	Options(string, httpcontract.HandlerFunc)
+	Any(string, httpcontract.HandlerFunc) Action
+	Get(string, httpcontract.HandlerFunc) Action
+	Post(string, httpcontract.HandlerFunc) Action
+	Delete(string, httpcontract.HandlerFunc) Action
+	Patch(string, httpcontract.HandlerFunc) Action
+	Put(string, httpcontract.HandlerFunc) Action
+	Options(string, httpcontract.HandlerFunc) Action
+	// WebSocket Register a GET route which upgrades the connection to WebSocket after the middlewares pass.
+	WebSocket(string, httpcontract.WebSocketHandler) Action
+
+	// Resource Register index, create, store, show, edit, update and destroy routes of a controller: /photos/{id}
+	Resource(path string, controller ResourceController)
+	// ApiResource Register index, store, show, update and destroy routes of a controller: /photos/{id}
+	ApiResource(path string, controller ApiResourceController)
 
 	Static(string, string)
 	StaticFile(string, string)
 	StaticFS(string, http.FileSystem)
+
+	// Url Generate the url of a named route, the parameters that don't belong to the path are appended as query string.
+	// The url of a route with a domain is scheme relative: //acme.example.com/users
+	Url(name string, params map[string]interface{}) (string, error)
+	// Signed Generate the url of a named route with a signature of app.key, it's verified by middleware.ValidateSignature.
+	// The host is signed too if the route has a domain.
+	Signed(name string, params map[string]interface{}) (string, error)
+	// TemporarySigned Generate the signed url of a named route, it expires at the expiration.
+	TemporarySigned(name string, expiration time.Time, params map[string]interface{}) (string, error)
+}
+
+//go:generate mockery --name=Action
+type Action interface {
+	// Name Set the name of the route, it can be used to generate the url of the route.
+	Name(name string) Action
+	// Summary Set the OpenAPI summary of the route.
+	Summary(summary string) Action
+	// Description Set the OpenAPI description of the route.
+	Description(description string) Action
+	// Tags Set the OpenAPI tags of the route.
+	Tags(tags ...string) Action
+	// Deprecated Mark the route as deprecated in the OpenAPI spec.
+	Deprecated() Action
+	// Request Declare the request of the route: Request(&requests.StoreUserRequest{})
+	Request(request interface{}) Action
+	// Response Declare the response of a status code, the response can be nil if there is no content: Response(200, &User{})
+	Response(code int, response interface{}) Action
+}
+
+type ApiResourceController interface {
+	// Index GET /photos
+	Index(ctx httpcontract.Context)
+	// Store POST /photos
+	Store(ctx httpcontract.Context)
+	// Show GET /photos/{id}
+	Show(ctx httpcontract.Context)
+	// Update PUT/PATCH /photos/{id}
+	Update(ctx httpcontract.Context)
+	// Destroy DELETE /photos/{id}
+	Destroy(ctx httpcontract.Context)
+}
+
+type ResourceController interface {
+	ApiResourceController
+	// Create GET /photos/create
+	Create(ctx httpcontract.Context)
+	// Edit GET /photos/{id}/edit
+	Edit(ctx httpcontract.Context)
 }
@@ -0,0 +1,80 @@
+// Code generated by mockery v2.12.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	session "github.com/goravel/framework/contracts/session"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Manager is an autogenerated mock type for the Manager type
+type Manager struct {
+	mock.Mock
+}
+
+// BuildSession provides a mock function with given fields: driver, id
+func (_m *Manager) BuildSession(driver session.Driver, id ...string) session.Session {
+	_va := make([]interface{}, len(id))
+	for _i := range id {
+		_va[_i] = id[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, driver)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 session.Session
+	if rf, ok := ret.Get(0).(func(session.Driver, ...string) session.Session); ok {
+		r0 = rf(driver, id...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(session.Session)
+		}
+	}
+
+	return r0
+}
+
+// Driver provides a mock function with given fields: name
+func (_m *Manager) Driver(name ...string) (session.Driver, error) {
+	_va := make([]interface{}, len(name))
+	for _i := range name {
+		_va[_i] = name[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 session.Driver
+	if rf, ok := ret.Get(0).(func(...string) session.Driver); ok {
+		r0 = rf(name...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(session.Driver)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(...string) error); ok {
+		r1 = rf(name...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type NewManagerT interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewManager creates a new instance of Manager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewManager(t NewManagerT) *Manager {
+	mock := &Manager{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,74 @@
+package session
+
+import (
+	"net/http"
+)
+
+//go:generate mockery --name=Manager
+type Manager interface {
+	// Driver Get a session driver by name, the session.driver config is used if the name is empty.
+	Driver(name ...string) (Driver, error)
+	// BuildSession Create a session stored by the driver, a new ID is generated if the ID is empty or invalid.
+	BuildSession(driver Driver, id ...string) Session
+}
+
+//go:generate mockery --name=Session
+type Session interface {
+	GetID() string
+	SetID(id string) Session
+	GetName() string
+	// Start Load the data of the session from the driver.
+	Start() bool
+	// Save the data of the session to the driver.
+	Save() error
+	IsStarted() bool
+
+	All() map[string]interface{}
+	// Exists Determine if the key exists, even if its value is nil.
+	Exists(key string) bool
+	// Has Determine if the key exists and its value isn't nil.
+	Has(key string) bool
+	Get(key string, defaultValue ...interface{}) interface{}
+	Put(key string, value interface{}) Session
+	// Pull Get the value of the key and remove it.
+	Pull(key string, defaultValue ...interface{}) interface{}
+	Forget(keys ...string) Session
+	// Flush Remove all the data of the session.
+	Flush() Session
+
+	// Flash Put a value which is only available in the current and the next request.
+	Flash(key string, value interface{}) Session
+	// Now Put a value which is only available in the current request.
+	Now(key string, value interface{}) Session
+	// Reflash Keep all the flash data for an additional request.
+	Reflash() Session
+	// Keep the given flash data for an additional request.
+	Keep(keys ...string) Session
+
+	// Token Get the CSRF token of the session.
+	Token() string
+	// RegenerateToken Generate a new CSRF token for the session.
+	RegenerateToken() Session
+
+	// Regenerate Generate a new ID for the session, the old session is removed from the driver if destroy is true.
+	Regenerate(destroy ...bool) error
+	// Invalidate Flush the data, regenerate the ID and the CSRF token of the session.
+	Invalidate() error
+}
+
+//go:generate mockery --name=Driver
+type Driver interface {
+	// Read the serialized data of the session, return an empty string if it doesn't exist.
+	Read(id string) (string, error)
+	Write(id string, data string) error
+	Destroy(id string) error
+	// Gc Remove the sessions which are older than the max lifetime, the unit is second.
+	Gc(maxLifetime int) error
+}
+
+// RequestDriver A driver stores the data in the request instead of the server, like the cookie driver.
+type RequestDriver interface {
+	Driver
+	// WithRequest Get a copy of the driver which reads from the request and writes to the response.
+	WithRequest(w http.ResponseWriter, r *http.Request) Driver
+}
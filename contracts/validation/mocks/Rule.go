@@ -0,0 +1,74 @@
+// Code generated by mockery v2.12.3. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// Rule is an autogenerated mock type for the Rule type
+type Rule struct {
+	mock.Mock
+}
+
+// Message provides a mock function with given fields:
+func (_m *Rule) Message() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Passes provides a mock function with given fields: data, val, options
+func (_m *Rule) Passes(data map[string]interface{}, val interface{}, options ...string) bool {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, data, val)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(map[string]interface{}, interface{}, ...string) bool); ok {
+		r0 = rf(data, val, options...)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Signature provides a mock function with given fields:
+func (_m *Rule) Signature() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+type NewRuleT interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRule creates a new instance of Rule. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRule(t NewRuleT) *Rule {
+	mock := &Rule{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,72 @@
+// Code generated by mockery v2.12.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	validation "github.com/goravel/framework/contracts/validation"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Validator is an autogenerated mock type for the Validator type
+type Validator struct {
+	mock.Mock
+}
+
+// Bind provides a mock function with given fields: ptr
+func (_m *Validator) Bind(ptr interface{}) error {
+	ret := _m.Called(ptr)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(interface{}) error); ok {
+		r0 = rf(ptr)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Errors provides a mock function with given fields:
+func (_m *Validator) Errors() validation.Errors {
+	ret := _m.Called()
+
+	var r0 validation.Errors
+	if rf, ok := ret.Get(0).(func() validation.Errors); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(validation.Errors)
+		}
+	}
+
+	return r0
+}
+
+// Fails provides a mock function with given fields:
+func (_m *Validator) Fails() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+type NewValidatorT interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewValidator creates a new instance of Validator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewValidator(t NewValidatorT) *Validator {
+	mock := &Validator{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
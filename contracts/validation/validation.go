@@ -0,0 +1,54 @@
+package validation
+
+//go:generate mockery --name=Validation
+type Validation interface {
+	//Make Create a new validator instance, data can be a map or a struct.
+	Make(data interface{}, rules map[string]string, options ...Option) (Validator, error)
+	//AddRules Register custom rules.
+	AddRules(rules []Rule) error
+	//Rules Get the custom rules.
+	Rules() []Rule
+}
+
+//go:generate mockery --name=Validator
+type Validator interface {
+	//Bind the validated data to a struct, use the `form` tag to specify field names.
+	Bind(ptr interface{}) error
+	//Errors Get the validation errors.
+	Errors() Errors
+	//Fails Determine if the data fails the validation rules.
+	Fails() bool
+}
+
+//go:generate mockery --name=Errors
+type Errors interface {
+	//One Get the first error message of a field, or of all fields if key is empty.
+	One(key ...string) string
+	//Get all error messages of a field, keyed by rule.
+	Get(key string) map[string]string
+	//All Get all error messages, keyed by field and rule.
+	All() map[string]map[string]string
+	//Has Determine if a field has errors.
+	Has(key string) bool
+}
+
+//go:generate mockery --name=Rule
+type Rule interface {
+	//Signature The name of the rule, used in rule strings: "required|my_rule:1,2".
+	Signature() string
+	//Passes Determine if the validation rule passes.
+	Passes(data map[string]interface{}, val interface{}, options ...string) bool
+	//Message Get the validation error message, supports the :attribute placeholder.
+	Message() string
+}
+
+type (
+	Option func(options *Options)
+)
+
+type Options struct {
+	//Messages Custom messages, keyed by "field.rule" or "rule".
+	Messages map[string]string
+	//Attributes Custom attribute names, keyed by field.
+	Attributes map[string]string
+}
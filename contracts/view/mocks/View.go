@@ -0,0 +1,108 @@
+// Code generated by mockery v2.12.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	io "io"
+	fs "io/fs"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// View is an autogenerated mock type for the View type
+type View struct {
+	mock.Mock
+}
+
+// Exists provides a mock function with given fields: name
+func (_m *View) Exists(name string) bool {
+	ret := _m.Called(name)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// FS provides a mock function with given fields: fsys
+func (_m *View) FS(fsys fs.FS) {
+	_m.Called(fsys)
+}
+
+// Funcs provides a mock function with given fields: funcs
+func (_m *View) Funcs(funcs map[string]interface{}) {
+	_m.Called(funcs)
+}
+
+// GetShared provides a mock function with given fields:
+func (_m *View) GetShared() map[string]interface{} {
+	ret := _m.Called()
+
+	var r0 map[string]interface{}
+	if rf, ok := ret.Get(0).(func() map[string]interface{}); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]interface{})
+		}
+	}
+
+	return r0
+}
+
+// Render provides a mock function with given fields: w, name, data
+func (_m *View) Render(w io.Writer, name string, data interface{}) error {
+	ret := _m.Called(w, name, data)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(io.Writer, string, interface{}) error); ok {
+		r0 = rf(w, name, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Share provides a mock function with given fields: key, value
+func (_m *View) Share(key string, value interface{}) {
+	_m.Called(key, value)
+}
+
+// Shared provides a mock function with given fields: key, def
+func (_m *View) Shared(key string, def ...interface{}) interface{} {
+	var _ca []interface{}
+	_ca = append(_ca, key)
+	_ca = append(_ca, def...)
+	ret := _m.Called(_ca...)
+
+	var r0 interface{}
+	if rf, ok := ret.Get(0).(func(string, ...interface{}) interface{}); ok {
+		r0 = rf(key, def...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	return r0
+}
+
+type NewViewT interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewView creates a new instance of View. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewView(t NewViewT) *View {
+	mock := &View{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
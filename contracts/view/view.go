@@ -0,0 +1,24 @@
+package view
+
+import (
+	"io"
+	"io/fs"
+)
+
+//go:generate mockery --name=View
+type View interface {
+	// Exists Determine if the view exists, the name is the path relative to the views directory without the extension: users/show
+	Exists(name string) bool
+	// Share Add a piece of shared data to all views, it's merged into the data when the data is a map.
+	Share(key string, value interface{})
+	// Shared Get a piece of shared data.
+	Shared(key string, def ...interface{}) interface{}
+	// GetShared Get all of the shared data.
+	GetShared() map[string]interface{}
+	// Funcs Add custom functions to the templates, they are available in all views.
+	Funcs(funcs map[string]interface{})
+	// FS Load the views from a file system instead of the view.path config, e.g. an embed.FS in production binaries.
+	FS(fsys fs.FS)
+	// Render Render the view with the data to the writer.
+	Render(w io.Writer, name string, data interface{}) error
+}
@@ -211,6 +211,25 @@ func (r *GormQuery) OrWhere(query interface{}, args ...interface{}) contractsorm
 	return NewGormQuery(tx)
 }
 
+func (r *GormQuery) Paginate(page, limit int, dest interface{}, total *int64) error {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = 1
+	}
+
+	query := r.instance.Session(&gorm.Session{})
+	if query.Statement.Model == nil {
+		query = query.Model(dest)
+	}
+	if err := query.Count(total).Error; err != nil {
+		return err
+	}
+
+	return r.instance.Session(&gorm.Session{}).Offset((page - 1) * limit).Limit(limit).Find(dest).Error
+}
+
 func (r *GormQuery) Pluck(column string, dest interface{}) error {
 	return r.instance.Pluck(column, dest).Error
 }
@@ -3,9 +3,12 @@ package database
 import (
 	"errors"
 	"fmt"
+	"path/filepath"
+	"strconv"
 	"testing"
 
 	"github.com/goravel/framework/contracts/config/mocks"
+	contractsorm "github.com/goravel/framework/contracts/database/orm"
 	"github.com/goravel/framework/database/support"
 	"github.com/goravel/framework/testing/mock"
 
@@ -131,3 +134,62 @@ func TestGetGormConfig(t *testing.T) {
 		assert.Equal(t, test.expectErr, err)
 	}
 }
+
+type PaginateUser struct {
+	ID   uint
+	Name string
+}
+
+func TestPaginate(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "goravel.db")), &gorm.Config{})
+	assert.Nil(t, err)
+	assert.Nil(t, db.AutoMigrate(&PaginateUser{}))
+	for i := 1; i <= 5; i++ {
+		assert.Nil(t, db.Create(&PaginateUser{Name: "user" + strconv.Itoa(i)}).Error)
+	}
+
+	tests := []struct {
+		name        string
+		query       func(query contractsorm.Query) contractsorm.Query
+		page        int
+		limit       int
+		expectIDs   []uint
+		expectTotal int64
+	}{
+		{name: "the second page", page: 2, limit: 2, expectIDs: []uint{3, 4}, expectTotal: 5},
+		{name: "beyond the last page", page: 4, limit: 2, expectIDs: []uint{}, expectTotal: 5},
+		{name: "page less than 1", page: 0, limit: 2, expectIDs: []uint{1, 2}, expectTotal: 5},
+		{name: "limit less than 1", page: 2, limit: 0, expectIDs: []uint{2}, expectTotal: 5},
+		{
+			name: "with order",
+			query: func(query contractsorm.Query) contractsorm.Query {
+				return query.Order("id desc")
+			},
+			page: 1, limit: 2, expectIDs: []uint{5, 4}, expectTotal: 5,
+		},
+		{
+			name: "with where",
+			query: func(query contractsorm.Query) contractsorm.Query {
+				return query.Where("id > ?", 1)
+			},
+			page: 2, limit: 3, expectIDs: []uint{5}, expectTotal: 4,
+		},
+	}
+
+	for _, test := range tests {
+		query := NewGormQuery(db)
+		if test.query != nil {
+			query = test.query(query)
+		}
+
+		var users []PaginateUser
+		var total int64
+		assert.Nil(t, query.Paginate(test.page, test.limit, &users, &total), test.name)
+		ids := []uint{}
+		for _, user := range users {
+			ids = append(ids, user.ID)
+		}
+		assert.Equal(t, test.expectIDs, ids, test.name)
+		assert.Equal(t, test.expectTotal, total, test.name)
+	}
+}
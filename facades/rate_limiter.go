@@ -0,0 +1,7 @@
+package facades
+
+import (
+	"github.com/goravel/framework/contracts/http"
+)
+
+var RateLimiter http.RateLimiter
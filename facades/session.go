@@ -0,0 +1,7 @@
+package facades
+
+import (
+	"github.com/goravel/framework/contracts/session"
+)
+
+var Session session.Manager
@@ -0,0 +1,7 @@
+package facades
+
+import (
+	"github.com/goravel/framework/contracts/validation"
+)
+
+var Validation validation.Validation
@@ -3,6 +3,7 @@ package filesystem
 import (
 	"context"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
@@ -85,6 +86,12 @@ func (r *Cos) Put(file string, content string) error {
 	return err
 }
 
+func (r *Cos) PutStream(file string, content io.Reader) error {
+	_, err := r.instance.Object.Put(r.ctx, file, content, nil)
+
+	return err
+}
+
 func (r *Cos) PutFile(filePath string, source filesystem.File) (string, error) {
 	return r.PutFileAs(filePath, source, str.Random(40))
 }
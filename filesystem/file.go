@@ -15,10 +15,13 @@ import (
 	"github.com/goravel/framework/support/str"
 )
 
+var ErrorMimeTypeNotAllowed = errors.New("the mime type of the file isn't allowed")
+
 type File struct {
-	disk     string
-	file     string
-	filename string
+	disk             string
+	file             string
+	filename         string
+	allowedMimeTypes []string
 }
 
 func NewFile(file string) (*File, error) {
@@ -65,10 +68,18 @@ func (f *File) File() string {
 }
 
 func (f *File) Store(path string) (string, error) {
+	if err := f.checkMimeType(); err != nil {
+		return "", err
+	}
+
 	return facades.Storage.Disk(f.disk).PutFile(path, f)
 }
 
 func (f *File) StoreAs(path string, name string) (string, error) {
+	if err := f.checkMimeType(); err != nil {
+		return "", err
+	}
+
 	return facades.Storage.Disk(f.disk).PutFileAs(path, f, name)
 }
 
@@ -97,3 +108,29 @@ func (f *File) HashName(path ...string) string {
 func (f *File) Extension() (string, error) {
 	return supportfile.Extension(f.file)
 }
+
+func (f *File) MimeType() (string, error) {
+	return supportfile.MimeType(f.file)
+}
+
+func (f *File) AllowedMimeTypes(mimeTypes ...string) filesystem.File {
+	f.allowedMimeTypes = mimeTypes
+
+	return f
+}
+
+func (f *File) checkMimeType() error {
+	if len(f.allowedMimeTypes) == 0 {
+		return nil
+	}
+
+	mimeType, err := f.MimeType()
+	if err != nil {
+		return err
+	}
+	if !supportfile.MimeTypeAllowed(mimeType, f.allowedMimeTypes) {
+		return ErrorMimeTypeNotAllowed
+	}
+
+	return nil
+}
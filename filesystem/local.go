@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"path"
@@ -13,6 +14,7 @@ import (
 
 	"github.com/goravel/framework/contracts/filesystem"
 	"github.com/goravel/framework/facades"
+	"github.com/goravel/framework/support/crypt"
 	"github.com/goravel/framework/support/str"
 )
 
@@ -51,6 +53,23 @@ func (r *Local) Put(file, content string) error {
 	return nil
 }
 
+func (r *Local) PutStream(file string, content io.Reader) error {
+	file = r.fullPath(file)
+	if err := os.MkdirAll(path.Dir(file), os.ModePerm); err != nil {
+		return err
+	}
+
+	f, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.Copy(f, content)
+
+	return err
+}
+
 func (r *Local) PutFile(filePath string, source filesystem.File) (string, error) {
 	return r.PutFileAs(filePath, source, str.Random(40))
 }
@@ -122,8 +141,10 @@ func (r *Local) Url(file string) string {
 	return strings.TrimSuffix(r.url, "/") + "/" + strings.TrimPrefix(file, "/")
 }
 
+// TemporaryUrl Generate the url of the file signed with app.key, it expires at the time.
+// The route serving the files should use middleware.ValidateSignature to verify the url.
 func (r *Local) TemporaryUrl(file string, time time.Time) (string, error) {
-	return r.Url(file), nil
+	return crypt.SignUrl(facades.Config.GetString("app.key"), r.Url(file), time)
 }
 
 func (r *Local) Copy(originFile, targetFile string) error {
@@ -0,0 +1,67 @@
+package filesystem
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/support/crypt"
+	"github.com/goravel/framework/testing/mock"
+)
+
+func TestLocalTemporaryUrl(t *testing.T) {
+	mockConfig := mock.Config()
+	mockConfig.On("GetString", "app.key").Return("12345678901234567890123456789012")
+
+	local := &Local{root: t.TempDir(), url: "https://goravel.dev/storage/"}
+
+	tests := []struct {
+		name        string
+		expiration  time.Time
+		modify      func(u *url.URL)
+		expectError error
+	}{
+		{
+			name:       "valid",
+			expiration: time.Now().Add(time.Hour),
+		},
+		{
+			name:        "expired",
+			expiration:  time.Now().Add(-time.Minute),
+			expectError: crypt.ErrorExpiredSignature,
+		},
+		{
+			name:       "another file",
+			expiration: time.Now().Add(time.Hour),
+			modify: func(u *url.URL) {
+				u.Path = "/storage/other.txt"
+			},
+			expectError: crypt.ErrorInvalidSignature,
+		},
+		{
+			name:       "another host",
+			expiration: time.Now().Add(time.Hour),
+			modify: func(u *url.URL) {
+				u.Host = "example.com"
+			},
+			expectError: crypt.ErrorInvalidSignature,
+		},
+	}
+
+	for _, test := range tests {
+		signed, err := local.TemporaryUrl("/avatars/goravel.png", test.expiration)
+		assert.Nil(t, err, test.name)
+		assert.Contains(t, signed, "https://goravel.dev/storage/avatars/goravel.png?expires=", test.name)
+
+		u, err := url.Parse(signed)
+		assert.Nil(t, err, test.name)
+		if test.modify != nil {
+			test.modify(u)
+		}
+		assert.Equal(t, test.expectError, crypt.VerifyUrl("12345678901234567890123456789012", u), test.name)
+	}
+
+	mockConfig.AssertExpectations(t)
+}
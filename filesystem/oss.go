@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"strconv"
@@ -81,6 +82,10 @@ func (r *Oss) Put(file string, content string) error {
 	return r.bucketInstance.PutObjectFromFile(file, tempFile.Name())
 }
 
+func (r *Oss) PutStream(file string, content io.Reader) error {
+	return r.bucketInstance.PutObject(file, content)
+}
+
 func (r *Oss) PutFile(filePath string, source filesystem.File) (string, error) {
 	return r.PutFileAs(filePath, source, str.Random(40))
 }
@@ -1,8 +1,11 @@
 package filesystem
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"strings"
@@ -25,6 +28,9 @@ import (
  * More: https://aws.github.io/aws-sdk-go-v2/docs/sdk-utilities/s3/#putobjectinput-body-field-ioreadseeker-vs-ioreader
  */
 
+// s3PartSize The minimum size of the parts of a multipart upload.
+const s3PartSize = 5 << 20
+
 type S3 struct {
 	ctx      context.Context
 	instance *s3.Client
@@ -73,6 +79,74 @@ func (r *S3) Put(file string, content string) error {
 	return err
 }
 
+// PutStream Upload the content part by part, so only a part is buffered in memory. The content smaller than
+// a part is uploaded directly.
+func (r *S3) PutStream(file string, content io.Reader) error {
+	buffer := make([]byte, s3PartSize)
+	n, err := io.ReadFull(content, buffer)
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		_, err = r.instance.PutObject(r.ctx, &s3.PutObjectInput{
+			Bucket: aws.String(r.bucket),
+			Key:    aws.String(file),
+			Body:   bytes.NewReader(buffer[:n]),
+		})
+
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	upload, err := r.instance.CreateMultipartUpload(r.ctx, &s3.CreateMultipartUploadInput{
+		Bucket: aws.String(r.bucket),
+		Key:    aws.String(file),
+	})
+	if err != nil {
+		return err
+	}
+	abort := func(err error) error {
+		_, _ = r.instance.AbortMultipartUpload(r.ctx, &s3.AbortMultipartUploadInput{
+			Bucket:   aws.String(r.bucket),
+			Key:      aws.String(file),
+			UploadId: upload.UploadId,
+		})
+
+		return err
+	}
+
+	var parts []types.CompletedPart
+	for number := int32(1); n > 0; number++ {
+		part, err := r.instance.UploadPart(r.ctx, &s3.UploadPartInput{
+			Bucket:     aws.String(r.bucket),
+			Key:        aws.String(file),
+			UploadId:   upload.UploadId,
+			PartNumber: number,
+			Body:       bytes.NewReader(buffer[:n]),
+		})
+		if err != nil {
+			return abort(err)
+		}
+		parts = append(parts, types.CompletedPart{ETag: part.ETag, PartNumber: number})
+
+		n, err = io.ReadFull(content, buffer)
+		if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return abort(err)
+		}
+	}
+
+	_, err = r.instance.CompleteMultipartUpload(r.ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(r.bucket),
+		Key:             aws.String(file),
+		UploadId:        upload.UploadId,
+		MultipartUpload: &types.CompletedMultipartUpload{Parts: parts},
+	})
+	if err != nil {
+		return abort(err)
+	}
+
+	return nil
+}
+
 func (r *S3) PutFile(filePath string, source filesystem.File) (string, error) {
 	return r.PutFileAs(filePath, source, str.Random(40))
 }
@@ -0,0 +1,122 @@
+package filesystem
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/h2non/filetype"
+
+	"github.com/goravel/framework/contracts/filesystem"
+	"github.com/goravel/framework/facades"
+	supportfile "github.com/goravel/framework/support/file"
+	"github.com/goravel/framework/support/str"
+)
+
+// sniffLength The length of the head of the content used to sniff the MIME type.
+const sniffLength = 512
+
+// StreamedFile A file read from a stream, like a part of a multipart request, it's stored without a temp copy.
+type StreamedFile struct {
+	disk             string
+	filename         string
+	reader           *bufio.Reader
+	allowedMimeTypes []string
+}
+
+func NewStreamedFile(filename string, content io.Reader) *StreamedFile {
+	return &StreamedFile{
+		disk:     facades.Config.GetString("filesystems.default"),
+		filename: filename,
+		reader:   bufio.NewReaderSize(content, sniffLength),
+	}
+}
+
+func (f *StreamedFile) Read(p []byte) (int, error) {
+	return f.reader.Read(p)
+}
+
+func (f *StreamedFile) Disk(disk string) filesystem.StreamedFile {
+	f.disk = disk
+
+	return f
+}
+
+func (f *StreamedFile) Store(filePath string) (string, error) {
+	return f.StoreAs(filePath, str.Random(40))
+}
+
+// StoreAs Store the file with the name, the extension of the content is appended if the name has no extension.
+func (f *StreamedFile) StoreAs(filePath string, name string) (string, error) {
+	mimeType, err := f.MimeType()
+	if err != nil {
+		return "", err
+	}
+	if len(f.allowedMimeTypes) > 0 && !supportfile.MimeTypeAllowed(mimeType, f.allowedMimeTypes) {
+		return "", ErrorMimeTypeNotAllowed
+	}
+
+	name = strings.TrimPrefix(path.Base(name), "/")
+	if path.Ext(name) == "" {
+		if extension := f.extension(); extension != "" {
+			name += "." + extension
+		}
+	}
+
+	fullPath := strings.TrimSuffix(filePath, "/") + "/" + name
+	if err := facades.Storage.Disk(f.disk).PutStream(fullPath, f); err != nil {
+		return "", err
+	}
+
+	return fullPath, nil
+}
+
+func (f *StreamedFile) GetClientOriginalName() string {
+	return f.filename
+}
+
+func (f *StreamedFile) GetClientOriginalExtension() string {
+	return supportfile.ClientOriginalExtension(f.filename)
+}
+
+func (f *StreamedFile) HashName(filePath ...string) string {
+	var realPath string
+	if len(filePath) > 0 {
+		realPath = strings.TrimRight(filePath[0], "/") + "/"
+	}
+
+	extension := f.extension()
+	if extension == "" {
+		return realPath + str.Random(40)
+	}
+
+	return realPath + str.Random(40) + "." + extension
+}
+
+// MimeType Get the MIME type by peeking the head of the content, the content isn't consumed.
+func (f *StreamedFile) MimeType() (string, error) {
+	head, err := f.reader.Peek(sniffLength)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", err
+	}
+
+	return supportfile.MimeTypeOf(head), nil
+}
+
+// extension Get the extension sniffed from the content, the client original one is used if it's unknown.
+func (f *StreamedFile) extension() string {
+	head, _ := f.reader.Peek(sniffLength)
+	if kind, err := filetype.Match(head); err == nil && kind != filetype.Unknown {
+		return kind.Extension
+	}
+
+	return f.GetClientOriginalExtension()
+}
+
+func (f *StreamedFile) AllowedMimeTypes(mimeTypes ...string) filesystem.StreamedFile {
+	f.allowedMimeTypes = mimeTypes
+
+	return f
+}
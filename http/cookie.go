@@ -0,0 +1,136 @@
+package http
+
+import (
+	"net/http"
+	"strings"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/facades"
+	"github.com/goravel/framework/support/crypt"
+)
+
+// GetCookie Get the value of a cookie, return the default value if the cookie doesn't exist or can't be decrypted.
+func GetCookie(request *http.Request, name string, defaultValue ...string) string {
+	var def string
+	if len(defaultValue) > 0 {
+		def = defaultValue[0]
+	}
+
+	cookie, err := request.Cookie(name)
+	if err != nil {
+		return def
+	}
+	if isCookieExcepted(name) {
+		return cookie.Value
+	}
+
+	value, err := DecryptCookie(name, cookie.Value)
+	if err != nil {
+		return def
+	}
+
+	return value
+}
+
+// EncryptCookie Encrypt the value of a cookie with app.key.
+func EncryptCookie(name, value string) (string, error) {
+	// The name is prefixed to the value before it's encrypted, so a value can't be moved to another cookie.
+	return crypt.Encrypt(facades.Config.GetString("app.key"), []byte(name+"|"+value))
+}
+
+// DecryptCookie Decrypt the value of a cookie encrypted by EncryptCookie.
+func DecryptCookie(name, payload string) (string, error) {
+	value, err := crypt.Decrypt(facades.Config.GetString("app.key"), payload)
+	if err != nil {
+		return "", err
+	}
+
+	prefix := name + "|"
+	if !strings.HasPrefix(string(value), prefix) {
+		return "", crypt.ErrorInvalidPayload
+	}
+
+	return strings.TrimPrefix(string(value), prefix), nil
+}
+
+// SetCookie Add a Set-Cookie header, the value is encrypted unless the cookie is listed in cookie.except.
+func SetCookie(w http.ResponseWriter, cookie contractshttp.Cookie) {
+	if !isCookieExcepted(cookie.Name) {
+		value, err := EncryptCookie(cookie.Name, cookie.Value)
+		if err != nil {
+			facades.Log.Error(err.Error())
+
+			return
+		}
+		cookie.Value = value
+	}
+
+	http.SetCookie(w, newCookie(cookie))
+}
+
+// RemoveCookie Expire a cookie on the client.
+func RemoveCookie(w http.ResponseWriter, name string) {
+	http.SetCookie(w, newCookie(contractshttp.Cookie{Name: name, MaxAge: -1}))
+}
+
+func newCookie(cookie contractshttp.Cookie) *http.Cookie {
+	if cookie.Path == "" {
+		cookie.Path = facades.Config.GetString("cookie.path", "/")
+	}
+	if cookie.Domain == "" {
+		cookie.Domain = facades.Config.GetString("cookie.domain")
+	}
+	if cookie.SameSite == "" {
+		cookie.SameSite = facades.Config.GetString("cookie.same_site", "lax")
+	}
+	secure := facades.Config.GetBool("cookie.secure")
+	if cookie.Secure != nil {
+		secure = *cookie.Secure
+	}
+	httpOnly := facades.Config.GetBool("cookie.http_only", true)
+	if cookie.HttpOnly != nil {
+		httpOnly = *cookie.HttpOnly
+	}
+
+	return &http.Cookie{
+		Name:     cookie.Name,
+		Value:    cookie.Value,
+		Path:     cookie.Path,
+		Domain:   cookie.Domain,
+		Expires:  cookie.Expires,
+		MaxAge:   cookie.MaxAge,
+		Secure:   secure,
+		HttpOnly: httpOnly,
+		SameSite: ParseSameSite(cookie.SameSite),
+	}
+}
+
+// isCookieExcepted Determine if the cookie is in the cookie.except config, it isn't encrypted.
+func isCookieExcepted(name string) bool {
+	excepts, ok := facades.Config.Get("cookie.except", []string{}).([]string)
+	if !ok {
+		return false
+	}
+
+	for _, except := range excepts {
+		if except == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseSameSite Parse the same_site config of the cookies: lax, strict or none.
+func ParseSameSite(value string) http.SameSite {
+	switch strings.ToLower(value) {
+	case "strict":
+		return http.SameSiteStrictMode
+	case "none":
+		return http.SameSiteNoneMode
+	case "lax":
+		return http.SameSiteLaxMode
+	}
+
+	return http.SameSiteDefaultMode
+}
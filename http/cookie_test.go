@@ -0,0 +1,61 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/testing/mock"
+)
+
+func TestCookie(t *testing.T) {
+	mockConfig := mock.Config()
+	mockConfig.On("GetString", "app.key").Return("12345678901234567890123456789012")
+	mockConfig.On("Get", "cookie.except", []string{}).Return([]string{"plain"})
+	mockConfig.On("GetString", "cookie.path", "/").Return("/")
+	mockConfig.On("GetString", "cookie.domain").Return("goravel.dev")
+	mockConfig.On("GetString", "cookie.same_site", "lax").Return("strict")
+	mockConfig.On("GetBool", "cookie.secure").Return(true)
+	mockConfig.On("GetBool", "cookie.http_only", true).Return(true)
+
+	readable := false
+	w := httptest.NewRecorder()
+	NewNetHttpContext(w, httptest.NewRequest(http.MethodGet, "/", nil), nil, nil).Response().
+		Cookie(contractshttp.Cookie{Name: "name", Value: "goravel", MaxAge: 60}).
+		Cookie(contractshttp.Cookie{Name: "plain", Value: "goravel", SameSite: "none", HttpOnly: &readable}).
+		WithoutCookie("removed")
+
+	cookies := make(map[string]*http.Cookie)
+	for _, cookie := range w.Result().Cookies() {
+		cookies[cookie.Name] = cookie
+	}
+	assert.Len(t, cookies, 3)
+	assert.NotEqual(t, "goravel", cookies["name"].Value)
+	assert.Equal(t, 60, cookies["name"].MaxAge)
+	assert.Equal(t, "goravel.dev", cookies["name"].Domain)
+	assert.Equal(t, http.SameSiteStrictMode, cookies["name"].SameSite)
+	assert.True(t, cookies["name"].Secure)
+	assert.True(t, cookies["name"].HttpOnly)
+	assert.Equal(t, "goravel", cookies["plain"].Value)
+	assert.Equal(t, http.SameSiteNoneMode, cookies["plain"].SameSite)
+	assert.True(t, cookies["plain"].Secure)
+	assert.False(t, cookies["plain"].HttpOnly)
+	assert.Equal(t, -1, cookies["removed"].MaxAge)
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.AddCookie(cookies["name"])
+	req.AddCookie(cookies["plain"])
+	req.AddCookie(&http.Cookie{Name: "invalid", Value: "goravel"})
+	// The encrypted value of a cookie can't be used by another cookie.
+	req.AddCookie(&http.Cookie{Name: "moved", Value: cookies["name"].Value})
+	request := NewNetHttpContext(httptest.NewRecorder(), req, nil, nil).Request()
+
+	assert.Equal(t, "goravel", request.Cookie("name"))
+	assert.Equal(t, "goravel", request.Cookie("plain"))
+	assert.Equal(t, "default", request.Cookie("invalid", "default"))
+	assert.Equal(t, "", request.Cookie("moved"))
+	assert.Equal(t, "default", request.Cookie("missing", "default"))
+}
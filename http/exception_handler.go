@@ -0,0 +1,195 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html/template"
+	"net/http"
+	"strings"
+
+	"github.com/goravel/framework/contracts/foundation"
+	contractshttp "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/facades"
+)
+
+// HttpError An error with the status code of the response, it isn't reported if the status code is less than 500.
+type HttpError struct {
+	Code    int
+	Message string
+}
+
+func NewHttpError(code int, message ...string) *HttpError {
+	err := &HttpError{Code: code, Message: http.StatusText(code)}
+	if len(message) > 0 {
+		err.Message = message[0]
+	}
+
+	return err
+}
+
+func (e *HttpError) Error() string {
+	return e.Message
+}
+
+func (e *HttpError) StatusCode() int {
+	return e.Code
+}
+
+// PanicError The error recovered from a panic, the stack is captured where the panic is recovered.
+type PanicError struct {
+	Value interface{}
+	stack []byte
+}
+
+func NewPanicError(value interface{}, stack []byte) *PanicError {
+	return &PanicError{Value: value, stack: stack}
+}
+
+func (e *PanicError) Error() string {
+	if err, ok := e.Value.(error); ok {
+		return err.Error()
+	}
+
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+func (e *PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+
+	return err
+}
+
+func (e *PanicError) Stack() []byte {
+	return e.stack
+}
+
+type ExceptionHandler struct {
+	dontReport []error
+}
+
+func NewExceptionHandler() *ExceptionHandler {
+	return &ExceptionHandler{}
+}
+
+func (h *ExceptionHandler) Report(ctx context.Context, err error) {
+	if err == nil || !h.ShouldReport(err) {
+		return
+	}
+
+	message := err.Error()
+	if httpCtx, ok := ctx.(contractshttp.Context); ok {
+		request := httpCtx.Request()
+		message = fmt.Sprintf("[%s %s %s] %s", request.Method(), request.FullUrl(), request.Ip(), message)
+	}
+	if stack := stackOf(err); len(stack) > 0 {
+		message += "\n" + string(stack)
+	}
+
+	facades.Log.WithContext(ctx).Error(message)
+}
+
+// Render The message of the server errors is hidden unless app.debug is true, the stack is rendered in app.debug as well.
+func (h *ExceptionHandler) Render(ctx contractshttp.Context, err error) {
+	status := statusOf(err)
+	debug := facades.Config.GetBool("app.debug")
+	message := err.Error()
+	if status >= http.StatusInternalServerError && !debug {
+		message = http.StatusText(status)
+	}
+
+	var stack []byte
+	if debug {
+		stack = stackOf(err)
+	}
+
+	if expectsJson(ctx.Request()) {
+		body := contractshttp.Json{"message": message}
+		if debug {
+			exception := err
+			if panicErr, ok := err.(*PanicError); ok && panicErr.Unwrap() != nil {
+				exception = panicErr.Unwrap()
+			}
+			body["exception"] = fmt.Sprintf("%T", exception)
+			if len(stack) > 0 {
+				body["trace"] = strings.Split(strings.TrimSpace(string(stack)), "\n")
+			}
+		}
+
+		ctx.Request().AbortWithStatusJson(status, body)
+
+		return
+	}
+
+	ctx.Response().Header("Content-Type", "text/html; charset=utf-8")
+	ctx.Request().AbortWithStatus(status)
+	_ = errorPage.Execute(ctx.Response().Writer(), map[string]interface{}{
+		"Status":  status,
+		"Title":   http.StatusText(status),
+		"Message": message,
+		"Stack":   string(stack),
+	})
+}
+
+func (h *ExceptionHandler) DontReport(errs ...error) foundation.ExceptionHandler {
+	h.dontReport = append(h.dontReport, errs...)
+
+	return h
+}
+
+// ShouldReport The client errors and the errors in the don't report list aren't reported.
+func (h *ExceptionHandler) ShouldReport(err error) bool {
+	if statusOf(err) < http.StatusInternalServerError {
+		return false
+	}
+
+	for _, dontReport := range h.dontReport {
+		if errors.Is(err, dontReport) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// statusOf Get the status code via the StatusCode method of the error, it's 500 by default.
+func statusOf(err error) int {
+	var statusErr interface{ StatusCode() int }
+	if errors.As(err, &statusErr) && statusErr.StatusCode() > 0 {
+		return statusErr.StatusCode()
+	}
+
+	return http.StatusInternalServerError
+}
+
+func stackOf(err error) []byte {
+	var stackErr interface{ Stack() []byte }
+	if errors.As(err, &stackErr) {
+		return stackErr.Stack()
+	}
+
+	return nil
+}
+
+func expectsJson(request contractshttp.Request) bool {
+	return strings.Contains(request.Header("Accept", ""), "json") ||
+		strings.EqualFold(request.Header("X-Requested-With", ""), "XMLHttpRequest")
+}
+
+var errorPage = template.Must(template.New("error").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{ .Status }} {{ .Title }}</title>
+<style>
+body { font-family: -apple-system, BlinkMacSystemFont, "Segoe UI", sans-serif; color: #333; margin: 40px; }
+h1 { font-size: 24px; font-weight: normal; }
+pre { background: #f5f5f5; padding: 16px; overflow: auto; font-size: 13px; }
+</style>
+</head>
+<body>
+<h1>{{ .Status }} | {{ .Message }}</h1>
+{{ if .Stack }}<pre>{{ .Stack }}</pre>{{ end }}
+</body>
+</html>
+`))
@@ -0,0 +1,24 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShouldReport(t *testing.T) {
+	errorIgnored := errors.New("ignored")
+	handler := NewExceptionHandler()
+	handler.DontReport(errorIgnored)
+
+	assert.True(t, handler.ShouldReport(errors.New("error")))
+	assert.True(t, handler.ShouldReport(NewPanicError("boom", nil)))
+	assert.True(t, handler.ShouldReport(NewHttpError(http.StatusServiceUnavailable)))
+	assert.False(t, handler.ShouldReport(errorIgnored))
+	assert.False(t, handler.ShouldReport(fmt.Errorf("wrapped: %w", errorIgnored)))
+	assert.False(t, handler.ShouldReport(NewPanicError(errorIgnored, nil)))
+	assert.False(t, handler.ShouldReport(NewHttpError(http.StatusNotFound)))
+}
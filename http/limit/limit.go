@@ -0,0 +1,71 @@
+package limit
+
+import (
+	"time"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+)
+
+type Limit struct {
+	key         string
+	maxAttempts int
+	decay       time.Duration
+	response    func(ctx contractshttp.Context)
+}
+
+func NewLimit(maxAttempts int, decay time.Duration) contractshttp.Limit {
+	return &Limit{maxAttempts: maxAttempts, decay: decay}
+}
+
+// PerSecond Create a limit of the given attempts per second.
+func PerSecond(maxAttempts int) contractshttp.Limit {
+	return NewLimit(maxAttempts, time.Second)
+}
+
+// PerMinute Create a limit of the given attempts per minute.
+func PerMinute(maxAttempts int) contractshttp.Limit {
+	return NewLimit(maxAttempts, time.Minute)
+}
+
+// PerMinutes Create a limit of the given attempts per the given minutes.
+func PerMinutes(decayMinutes, maxAttempts int) contractshttp.Limit {
+	return NewLimit(maxAttempts, time.Duration(decayMinutes)*time.Minute)
+}
+
+// PerHour Create a limit of the given attempts per hour.
+func PerHour(maxAttempts int) contractshttp.Limit {
+	return NewLimit(maxAttempts, time.Hour)
+}
+
+// PerDay Create a limit of the given attempts per day.
+func PerDay(maxAttempts int) contractshttp.Limit {
+	return NewLimit(maxAttempts, 24*time.Hour)
+}
+
+func (r *Limit) By(key string) contractshttp.Limit {
+	r.key = key
+
+	return r
+}
+
+func (r *Limit) Response(callback func(ctx contractshttp.Context)) contractshttp.Limit {
+	r.response = callback
+
+	return r
+}
+
+func (r *Limit) GetKey() string {
+	return r.key
+}
+
+func (r *Limit) GetMaxAttempts() int {
+	return r.maxAttempts
+}
+
+func (r *Limit) GetDecay() time.Duration {
+	return r.decay
+}
+
+func (r *Limit) GetResponse() func(ctx contractshttp.Context) {
+	return r.response
+}
@@ -0,0 +1,54 @@
+package middleware
+
+import (
+	nethttp "net/http"
+	"strconv"
+	"time"
+
+	"github.com/goravel/framework/auth"
+	contractshttp "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/facades"
+)
+
+// AccessLog Write an entry with the method, path, status, latency, IP and user of the request to facades.Log after
+// the request is handled, the entries of 5xx responses are errors, and the ones of 4xx responses are warnings.
+// The request id is added if the RequestId middleware is used before it.
+func AccessLog() contractshttp.Middleware {
+	return func(ctx contractshttp.Context) {
+		start := time.Now()
+		ctx.Request().Next()
+
+		status := nethttp.StatusOK
+		if writer, ok := ctx.Response().Writer().(interface{ Status() int }); ok {
+			status = writer.Status()
+		}
+		latency := time.Since(start)
+
+		fields := map[string]interface{}{
+			"method":     ctx.Request().Method(),
+			"path":       ctx.Request().Path(),
+			"status":     status,
+			"latency":    latency.String(),
+			"latency_ms": float64(latency.Microseconds()) / 1000,
+			"ip":         ctx.Request().Ip(),
+			"user_agent": ctx.Request().Header("User-Agent", ""),
+		}
+		if requestId, ok := ctx.Value(contractshttp.RequestIdKey).(string); ok {
+			fields["request_id"] = requestId
+		}
+		if user := auth.UserKey(ctx, facades.Config.GetString("auth.defaults.guard")); user != "" {
+			fields["user"] = user
+		}
+
+		writer := facades.Log.WithContext(ctx).WithFields(fields)
+		message := ctx.Request().Method() + " " + ctx.Request().Path() + " " + strconv.Itoa(status)
+		switch {
+		case status >= nethttp.StatusInternalServerError:
+			writer.Error(message)
+		case status >= nethttp.StatusBadRequest:
+			writer.Warning(message)
+		default:
+			writer.Info(message)
+		}
+	}
+}
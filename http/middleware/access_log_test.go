@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	nethttp "net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	testifymock "github.com/stretchr/testify/mock"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	logmocks "github.com/goravel/framework/contracts/log/mocks"
+	"github.com/goravel/framework/facades"
+	"github.com/goravel/framework/http"
+	"github.com/goravel/framework/testing/mock"
+)
+
+func TestAccessLog(t *testing.T) {
+	tests := []struct {
+		name         string
+		code         int
+		expectLevel  string
+		expectStatus int
+	}{
+		{name: "info for successful responses", code: nethttp.StatusOK, expectLevel: "Info", expectStatus: nethttp.StatusOK},
+		{name: "warning for client errors", code: nethttp.StatusNotFound, expectLevel: "Warning", expectStatus: nethttp.StatusNotFound},
+		{name: "error for server errors", code: nethttp.StatusBadGateway, expectLevel: "Error", expectStatus: nethttp.StatusBadGateway},
+	}
+
+	for _, test := range tests {
+		mockConfig := mock.Config()
+		mockConfig.On("GetString", "auth.defaults.guard").Return("user").Once()
+		mockLog := &logmocks.Log{}
+		mockWriter := &logmocks.Writer{}
+		facades.Log = mockLog
+
+		var fields map[string]interface{}
+		mockLog.On("WithContext", testifymock.Anything).Return(mockWriter).Once()
+		mockWriter.On("WithFields", testifymock.Anything).Run(func(args testifymock.Arguments) {
+			fields = args.Get(0).(map[string]interface{})
+		}).Return(mockWriter).Once()
+		mockWriter.On(test.expectLevel, "POST /users "+strconv.Itoa(test.code)).Once()
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(nethttp.MethodPost, "/users?page=1", nil)
+		req.Header.Set("User-Agent", "goravel")
+		http.NewNetHttpContext(w, req, nil, []contractshttp.HandlerFunc{
+			contractshttp.HandlerFunc(RequestId()),
+			contractshttp.HandlerFunc(AccessLog()),
+			func(ctx contractshttp.Context) {
+				ctx.Response().String(test.code, "goravel")
+			},
+		}).Next()
+
+		mockConfig.AssertExpectations(t)
+		mockLog.AssertExpectations(t)
+		mockWriter.AssertExpectations(t)
+		assert.Equal(t, "POST", fields["method"], test.name)
+		assert.Equal(t, "/users", fields["path"], test.name)
+		assert.Equal(t, test.expectStatus, fields["status"], test.name)
+		assert.Equal(t, "192.0.2.1", fields["ip"], test.name)
+		assert.Equal(t, "goravel", fields["user_agent"], test.name)
+		assert.Equal(t, w.Header().Get("X-Request-Id"), fields["request_id"], test.name)
+		assert.Contains(t, fields, "latency_ms", test.name)
+		assert.NotContains(t, fields, "user", test.name)
+	}
+}
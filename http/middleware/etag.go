@@ -0,0 +1,140 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	nethttp "net/http"
+	"strings"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/facades"
+)
+
+// ETag Add a weak ETag to the successful responses of GET and HEAD requests, 304 is responded if the ETag matches
+// If-None-Match, or the Last-Modified header of the response isn't after If-Modified-Since.
+// The response is buffered to compute the ETag, the flushed responses, like SSE, are written directly.
+func ETag() contractshttp.Middleware {
+	return func(ctx contractshttp.Context) {
+		request := ctx.Request().Origin()
+		if (request.Method != nethttp.MethodGet && request.Method != nethttp.MethodHead) || request.Header.Get("Upgrade") != "" {
+			ctx.Request().Next()
+
+			return
+		}
+
+		writer := &etagWriter{ResponseWriter: ctx.Response().Writer()}
+		ctx.Response().SetWriter(writer)
+		defer func() {
+			// The buffered response is dropped if the handlers panic, so the error response is written directly.
+			if recovered := recover(); recovered != nil {
+				ctx.Response().SetWriter(writer.ResponseWriter)
+				panic(recovered)
+			}
+			if err := writer.close(request); err != nil {
+				facades.Log.Error(err.Error())
+			}
+		}()
+
+		ctx.Request().Next()
+	}
+}
+
+// etagWriter Buffer the response until the handlers are executed or the response is flushed.
+type etagWriter struct {
+	nethttp.ResponseWriter
+	code      int
+	buffer    bytes.Buffer
+	streaming bool
+}
+
+func (w *etagWriter) WriteHeader(code int) {
+	if w.code == 0 {
+		w.code = code
+	}
+}
+
+func (w *etagWriter) Write(data []byte) (int, error) {
+	if w.streaming {
+		return w.ResponseWriter.Write(data)
+	}
+
+	return w.buffer.Write(data)
+}
+
+func (w *etagWriter) Flush() {
+	if !w.streaming {
+		w.streaming = true
+		_ = w.write()
+	}
+	if flusher, ok := w.ResponseWriter.(nethttp.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// close Write the buffered response, or 304 if the client has a fresh copy of it.
+func (w *etagWriter) close(request *nethttp.Request) error {
+	if w.streaming || (w.code == 0 && w.buffer.Len() == 0) {
+		return nil
+	}
+	if w.code != 0 && w.code != nethttp.StatusOK {
+		return w.write()
+	}
+
+	header := w.Header()
+	if header.Get("ETag") == "" {
+		hash := md5.Sum(w.buffer.Bytes())
+		header.Set("ETag", `W/"`+hex.EncodeToString(hash[:])+`"`)
+	}
+
+	if notModified(request, header) {
+		header.Del("Content-Type")
+		header.Del("Content-Length")
+		w.ResponseWriter.WriteHeader(nethttp.StatusNotModified)
+
+		return nil
+	}
+
+	return w.write()
+}
+
+func (w *etagWriter) write() error {
+	if w.code == 0 {
+		w.code = nethttp.StatusOK
+	}
+	w.ResponseWriter.WriteHeader(w.code)
+	if w.buffer.Len() == 0 {
+		return nil
+	}
+
+	_, err := w.ResponseWriter.Write(w.buffer.Bytes())
+	w.buffer.Reset()
+
+	return err
+}
+
+// notModified Determine if the client has a fresh copy of the response, If-Modified-Since is ignored if If-None-Match exists.
+func notModified(request *nethttp.Request, header nethttp.Header) bool {
+	if ifNoneMatch := request.Header.Get("If-None-Match"); ifNoneMatch != "" {
+		etag := strings.TrimPrefix(header.Get("ETag"), "W/")
+		for _, item := range strings.Split(ifNoneMatch, ",") {
+			item = strings.TrimSpace(item)
+			if item == "*" || strings.TrimPrefix(item, "W/") == etag {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	ifModifiedSince, err := nethttp.ParseTime(request.Header.Get("If-Modified-Since"))
+	if err != nil {
+		return false
+	}
+	lastModified, err := nethttp.ParseTime(header.Get("Last-Modified"))
+	if err != nil {
+		return false
+	}
+
+	return !lastModified.After(ifModifiedSince)
+}
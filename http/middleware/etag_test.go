@@ -0,0 +1,136 @@
+package middleware
+
+import (
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/http"
+)
+
+func TestETag(t *testing.T) {
+	etag := `W/"3e8f4e5ea6f6b5f8e9b3a0e4f30d9f2e"`
+	lastModified := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		method     string
+		header     map[string]string
+		handler    contractshttp.HandlerFunc
+		expectCode int
+		expectBody string
+		expectETag bool
+	}{
+		{
+			name:   "add etag",
+			method: nethttp.MethodGet,
+			handler: func(ctx contractshttp.Context) {
+				ctx.Response().String(nethttp.StatusOK, "goravel")
+			},
+			expectCode: nethttp.StatusOK,
+			expectBody: "goravel",
+			expectETag: true,
+		},
+		{
+			name:   "if none match",
+			method: nethttp.MethodGet,
+			header: map[string]string{"If-None-Match": `"other", ` + etagOf("goravel")},
+			handler: func(ctx contractshttp.Context) {
+				ctx.Response().String(nethttp.StatusOK, "goravel")
+			},
+			expectCode: nethttp.StatusNotModified,
+			expectETag: true,
+		},
+		{
+			name:   "if none match with custom etag",
+			method: nethttp.MethodGet,
+			header: map[string]string{"If-None-Match": `"v1"`},
+			handler: func(ctx contractshttp.Context) {
+				ctx.Response().Header("ETag", etag)
+				ctx.Response().String(nethttp.StatusOK, "goravel")
+			},
+			expectCode: nethttp.StatusOK,
+			expectBody: "goravel",
+			expectETag: true,
+		},
+		{
+			name:   "if modified since",
+			method: nethttp.MethodGet,
+			header: map[string]string{"If-Modified-Since": lastModified.Add(time.Hour).Format(nethttp.TimeFormat)},
+			handler: func(ctx contractshttp.Context) {
+				ctx.Response().Header("Last-Modified", lastModified.Format(nethttp.TimeFormat))
+				ctx.Response().String(nethttp.StatusOK, "goravel")
+			},
+			expectCode: nethttp.StatusNotModified,
+			expectETag: true,
+		},
+		{
+			name:   "modified",
+			method: nethttp.MethodGet,
+			header: map[string]string{"If-Modified-Since": lastModified.Add(-time.Hour).Format(nethttp.TimeFormat)},
+			handler: func(ctx contractshttp.Context) {
+				ctx.Response().Header("Last-Modified", lastModified.Format(nethttp.TimeFormat))
+				ctx.Response().String(nethttp.StatusOK, "goravel")
+			},
+			expectCode: nethttp.StatusOK,
+			expectBody: "goravel",
+			expectETag: true,
+		},
+		{
+			name:   "not successful",
+			method: nethttp.MethodGet,
+			header: map[string]string{"If-None-Match": "*"},
+			handler: func(ctx contractshttp.Context) {
+				ctx.Response().String(nethttp.StatusNotFound, "missing")
+			},
+			expectCode: nethttp.StatusNotFound,
+			expectBody: "missing",
+		},
+		{
+			name:   "abort",
+			method: nethttp.MethodGet,
+			handler: func(ctx contractshttp.Context) {
+				ctx.Request().AbortWithStatus(nethttp.StatusForbidden)
+			},
+			expectCode: nethttp.StatusForbidden,
+		},
+		{
+			name:   "post",
+			method: nethttp.MethodPost,
+			handler: func(ctx contractshttp.Context) {
+				ctx.Response().String(nethttp.StatusOK, "goravel")
+			},
+			expectCode: nethttp.StatusOK,
+			expectBody: "goravel",
+		},
+	}
+
+	for _, test := range tests {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(test.method, "/", nil)
+		for key, value := range test.header {
+			req.Header.Set(key, value)
+		}
+		serve(w, req, contractshttp.HandlerFunc(ETag()), test.handler)
+
+		assert.Equal(t, test.expectCode, w.Code, test.name)
+		assert.Equal(t, test.expectBody, w.Body.String(), test.name)
+		assert.Equal(t, test.expectETag, w.Header().Get("ETag") != "", test.name)
+	}
+}
+
+func etagOf(body string) string {
+	w := httptest.NewRecorder()
+	http.NewNetHttpContext(w, httptest.NewRequest(nethttp.MethodGet, "/", nil), nil, []contractshttp.HandlerFunc{
+		contractshttp.HandlerFunc(ETag()),
+		func(ctx contractshttp.Context) {
+			ctx.Response().String(nethttp.StatusOK, body)
+		},
+	}).Next()
+
+	return w.Header().Get("ETag")
+}
@@ -0,0 +1,261 @@
+package middleware
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"mime"
+	nethttp "net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/andybalholm/brotli"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/facades"
+)
+
+// encodings The supported encodings, the first one is preferred if the client accepts several with the same quality.
+var encodings = []string{"br", "gzip", "deflate"}
+
+var defaultCompressibleTypes = []string{
+	"text/html", "text/plain", "text/css", "text/csv", "text/xml", "text/javascript",
+	"application/json", "application/javascript", "application/xml", "application/x-yaml", "image/svg+xml",
+}
+
+// Gzip Compress the response by the Accept-Encoding of the request, br, gzip and deflate are supported.
+// The response is compressed only if its size reaches http.compression.min_length (1024 bytes by default)
+// and its Content-Type is in http.compression.content_types.
+func Gzip() contractshttp.Middleware {
+	return func(ctx contractshttp.Context) {
+		request := ctx.Request().Origin()
+		encoding := negotiateEncoding(request.Header.Get("Accept-Encoding"))
+		if encoding == "" || request.Method == nethttp.MethodHead || request.Header.Get("Upgrade") != "" || request.Header.Get("Range") != "" {
+			ctx.Request().Next()
+
+			return
+		}
+
+		writer := &compressWriter{
+			ResponseWriter: ctx.Response().Writer(),
+			encoding:       encoding,
+			level:          facades.Config.GetInt("http.compression.level", -1),
+			minLength:      facades.Config.GetInt("http.compression.min_length", 1024),
+			types:          defaultCompressibleTypes,
+		}
+		if types, ok := facades.Config.Get("http.compression.content_types", defaultCompressibleTypes).([]string); ok {
+			writer.types = types
+		}
+
+		ctx.Response().SetWriter(writer)
+		defer func() {
+			// The buffered response is dropped if the handlers panic, so the error response is written directly.
+			if recovered := recover(); recovered != nil {
+				ctx.Response().SetWriter(writer.ResponseWriter)
+				panic(recovered)
+			}
+			writer.close()
+		}()
+
+		ctx.Request().Next()
+	}
+}
+
+// negotiateEncoding Get the supported encoding with the highest quality in the Accept-Encoding header.
+func negotiateEncoding(header string) string {
+	qualities := make(map[string]float64)
+	for _, item := range strings.Split(header, ",") {
+		name, parameters, _ := strings.Cut(strings.TrimSpace(item), ";")
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+
+		quality := 1.0
+		if parameters = strings.TrimSpace(parameters); strings.HasPrefix(parameters, "q=") {
+			if parsed, err := strconv.ParseFloat(strings.TrimPrefix(parameters, "q="), 64); err == nil {
+				quality = parsed
+			}
+		}
+		qualities[name] = quality
+	}
+
+	candidates := make([]string, 0, len(encodings))
+	for _, encoding := range encodings {
+		quality, exist := qualities[encoding]
+		if !exist {
+			quality, exist = qualities["*"]
+		}
+		if exist && quality > 0 {
+			qualities[encoding] = quality
+			candidates = append(candidates, encoding)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return qualities[candidates[i]] > qualities[candidates[j]]
+	})
+
+	if len(candidates) == 0 {
+		return ""
+	}
+
+	return candidates[0]
+}
+
+// compressWriter Buffer the response until min length is reached, then decide if the response is compressed.
+type compressWriter struct {
+	nethttp.ResponseWriter
+	encoding   string
+	level      int
+	minLength  int
+	types      []string
+	code       int
+	buffer     bytes.Buffer
+	decided    bool
+	compressor io.WriteCloser
+}
+
+func (w *compressWriter) WriteHeader(code int) {
+	if w.code == 0 {
+		w.code = code
+	}
+}
+
+func (w *compressWriter) Write(data []byte) (int, error) {
+	if !w.decided {
+		w.buffer.Write(data)
+		if w.buffer.Len() >= w.minLength {
+			if err := w.decide(); err != nil {
+				return 0, err
+			}
+		}
+
+		return len(data), nil
+	}
+
+	if w.compressor != nil {
+		return w.compressor.Write(data)
+	}
+
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *compressWriter) Flush() {
+	if !w.decided {
+		_ = w.decide()
+	}
+	if flusher, ok := w.compressor.(interface{ Flush() error }); ok {
+		_ = flusher.Flush()
+	}
+	if flusher, ok := w.ResponseWriter.(nethttp.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// decide Write the header and the buffered data, the data is compressed if the response is compressible.
+func (w *compressWriter) decide() error {
+	w.decided = true
+	if w.code == 0 {
+		w.code = nethttp.StatusOK
+	}
+
+	header := w.Header()
+	if w.compressible() {
+		header.Set("Content-Encoding", w.encoding)
+		header.Add("Vary", "Accept-Encoding")
+		header.Del("Content-Length")
+		w.compressor = w.newCompressor()
+	}
+
+	w.ResponseWriter.WriteHeader(w.code)
+	if w.buffer.Len() == 0 {
+		return nil
+	}
+
+	var err error
+	if w.compressor != nil {
+		_, err = w.compressor.Write(w.buffer.Bytes())
+	} else {
+		_, err = w.ResponseWriter.Write(w.buffer.Bytes())
+	}
+	w.buffer.Reset()
+
+	return err
+}
+
+func (w *compressWriter) compressible() bool {
+	if w.buffer.Len() < w.minLength || w.code < nethttp.StatusOK ||
+		w.code == nethttp.StatusNoContent || w.code == nethttp.StatusNotModified {
+		return false
+	}
+
+	header := w.Header()
+	if header.Get("Content-Encoding") != "" {
+		return false
+	}
+
+	contentType := header.Get("Content-Type")
+	if contentType == "" {
+		contentType = nethttp.DetectContentType(w.buffer.Bytes())
+		header.Set("Content-Type", contentType)
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	for _, item := range w.types {
+		if item == "*" || strings.EqualFold(item, mediaType) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (w *compressWriter) newCompressor() io.WriteCloser {
+	switch w.encoding {
+	case "br":
+		level := w.level
+		if level < brotli.BestSpeed || level > brotli.BestCompression {
+			level = brotli.DefaultCompression
+		}
+
+		return brotli.NewWriterLevel(w.ResponseWriter, level)
+	case "deflate":
+		// The deflate encoding of HTTP is the zlib format.
+		writer, err := zlib.NewWriterLevel(w.ResponseWriter, w.level)
+		if err != nil {
+			writer, _ = zlib.NewWriterLevel(w.ResponseWriter, zlib.DefaultCompression)
+		}
+
+		return writer
+	default:
+		writer, err := gzip.NewWriterLevel(w.ResponseWriter, w.level)
+		if err != nil {
+			writer, _ = gzip.NewWriterLevel(w.ResponseWriter, gzip.DefaultCompression)
+		}
+
+		return writer
+	}
+}
+
+// close Write the rest of the response, it's called after the handlers are executed.
+func (w *compressWriter) close() {
+	if !w.decided {
+		if w.code == 0 && w.buffer.Len() == 0 {
+			return
+		}
+		if err := w.decide(); err != nil {
+			facades.Log.Error(err.Error())
+		}
+	}
+
+	if w.compressor != nil {
+		if err := w.compressor.Close(); err != nil {
+			facades.Log.Error(err.Error())
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package middleware
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	nethttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/andybalholm/brotli"
+	"github.com/stretchr/testify/assert"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/http"
+	"github.com/goravel/framework/testing/mock"
+)
+
+func TestGzip(t *testing.T) {
+	large := strings.Repeat(`{"name":"goravel"}`, 100)
+
+	tests := []struct {
+		name           string
+		acceptEncoding string
+		handler        contractshttp.HandlerFunc
+		expectEncoding string
+		expectBody     string
+		expectCode     int
+	}{
+		{
+			name:           "gzip",
+			acceptEncoding: "gzip, deflate",
+			handler: func(ctx contractshttp.Context) {
+				ctx.Response().String(nethttp.StatusCreated, large)
+			},
+			expectEncoding: "gzip",
+			expectBody:     large,
+			expectCode:     nethttp.StatusCreated,
+		},
+		{
+			name:           "brotli is preferred",
+			acceptEncoding: "gzip, deflate, br",
+			handler: func(ctx contractshttp.Context) {
+				ctx.Response().Json(nethttp.StatusOK, contractshttp.Json{"data": large})
+			},
+			expectEncoding: "br",
+			expectCode:     nethttp.StatusOK,
+		},
+		{
+			name:           "deflate by quality",
+			acceptEncoding: "gzip;q=0.5, deflate, br;q=0",
+			handler: func(ctx contractshttp.Context) {
+				ctx.Response().String(nethttp.StatusOK, large)
+			},
+			expectEncoding: "deflate",
+			expectBody:     large,
+			expectCode:     nethttp.StatusOK,
+		},
+		{
+			name:           "unsupported encoding",
+			acceptEncoding: "compress",
+			handler: func(ctx contractshttp.Context) {
+				ctx.Response().String(nethttp.StatusOK, large)
+			},
+			expectBody: large,
+			expectCode: nethttp.StatusOK,
+		},
+		{
+			name:           "smaller than min length",
+			acceptEncoding: "gzip",
+			handler: func(ctx contractshttp.Context) {
+				ctx.Response().String(nethttp.StatusOK, "goravel")
+			},
+			expectBody: "goravel",
+			expectCode: nethttp.StatusOK,
+		},
+		{
+			name:           "content type isn't allowed",
+			acceptEncoding: "gzip",
+			handler: func(ctx contractshttp.Context) {
+				ctx.Response().Header("Content-Type", "image/png")
+				_, _ = ctx.Response().Writer().Write([]byte(large))
+			},
+			expectBody: large,
+			expectCode: nethttp.StatusOK,
+		},
+		{
+			name:           "abort",
+			acceptEncoding: "gzip",
+			handler: func(ctx contractshttp.Context) {
+				ctx.Request().AbortWithStatus(nethttp.StatusForbidden)
+			},
+			expectCode: nethttp.StatusForbidden,
+		},
+	}
+
+	for _, test := range tests {
+		mockConfig := mock.Config()
+		mockConfig.On("GetInt", "http.compression.level", -1).Return(-1)
+		mockConfig.On("GetInt", "http.compression.min_length", 1024).Return(1024)
+		mockConfig.On("Get", "http.compression.content_types", defaultCompressibleTypes).Return(defaultCompressibleTypes)
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(nethttp.MethodGet, "/", nil)
+		req.Header.Set("Accept-Encoding", test.acceptEncoding)
+		serve(w, req, contractshttp.HandlerFunc(Gzip()), test.handler)
+
+		assert.Equal(t, test.expectCode, w.Code, test.name)
+		assert.Equal(t, test.expectEncoding, w.Header().Get("Content-Encoding"), test.name)
+
+		var reader io.Reader = w.Body
+		switch test.expectEncoding {
+		case "gzip":
+			gzipReader, err := gzip.NewReader(w.Body)
+			assert.Nil(t, err, test.name)
+			reader = gzipReader
+		case "deflate":
+			zlibReader, err := zlib.NewReader(w.Body)
+			assert.Nil(t, err, test.name)
+			reader = zlibReader
+		case "br":
+			reader = brotli.NewReader(w.Body)
+		}
+		body, err := io.ReadAll(reader)
+		assert.Nil(t, err, test.name)
+		if test.expectEncoding != "" {
+			assert.Equal(t, "Accept-Encoding", w.Header().Get("Vary"), test.name)
+		}
+		if test.expectBody != "" {
+			assert.Equal(t, test.expectBody, string(body), test.name)
+		}
+		if test.expectEncoding == "br" {
+			assert.True(t, bytes.Contains(body, []byte("goravel")), test.name)
+		}
+	}
+}
+
+func TestNegotiateEncoding(t *testing.T) {
+	assert.Equal(t, "br", negotiateEncoding("gzip, br"))
+	assert.Equal(t, "gzip", negotiateEncoding("gzip;q=1.0, br;q=0.8"))
+	assert.Equal(t, "br", negotiateEncoding("*"))
+	assert.Equal(t, "gzip", negotiateEncoding("*;q=0.5, br;q=0, gzip"))
+	assert.Equal(t, "", negotiateEncoding("identity"))
+	assert.Equal(t, "", negotiateEncoding(""))
+}
+
+// serve Serve the request with the handlers via NetHttpContext.
+func serve(w nethttp.ResponseWriter, req *nethttp.Request, handlers ...contractshttp.HandlerFunc) {
+	http.NewNetHttpContext(w, req, nil, handlers).Next()
+}
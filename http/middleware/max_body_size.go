@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	nethttp "net/http"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/http"
+)
+
+// MaxBodySize Limit the size of the request body in bytes, it's used by route groups to override the limit:
+// facades.Route.Middleware(middleware.MaxBodySize(100 << 20)).Group(...)
+// The request is rejected with 413 if its Content-Length exceeds the size, otherwise reading the body fails once it does.
+// The rejection is rendered by facades.ExceptionHandler.
+func MaxBodySize(size int64) contractshttp.Middleware {
+	return func(ctx contractshttp.Context) {
+		request := ctx.Request().Origin()
+		if request.ContentLength > size {
+			exceptionHandler().Render(ctx, http.NewHttpError(nethttp.StatusRequestEntityTooLarge))
+
+			return
+		}
+
+		request.Body = nethttp.MaxBytesReader(ctx.Response().Writer(), request.Body, size)
+		ctx.Request().Next()
+	}
+}
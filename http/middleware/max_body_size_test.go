@@ -0,0 +1,85 @@
+package middleware
+
+import (
+	"io"
+	nethttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/http"
+	"github.com/goravel/framework/testing/mock"
+)
+
+func TestMaxBodySize(t *testing.T) {
+	mockConfig := mock.Config()
+	mockConfig.On("GetBool", "app.debug").Return(false)
+
+	tests := []struct {
+		name          string
+		accept        string
+		body          string
+		chunked       bool
+		expectCode    int
+		expectBody    string
+		expectReadErr bool
+	}{
+		{
+			name:       "within the limit",
+			body:       "goravel",
+			expectCode: nethttp.StatusOK,
+			expectBody: "goravel",
+		},
+		{
+			name:       "content length exceeds the limit",
+			accept:     "application/json",
+			body:       strings.Repeat("a", 11),
+			expectCode: nethttp.StatusRequestEntityTooLarge,
+			expectBody: `{"message":"Request Entity Too Large"}`,
+		},
+		{
+			name:       "content length exceeds the limit from a browser",
+			accept:     "text/html",
+			body:       strings.Repeat("a", 11),
+			expectCode: nethttp.StatusRequestEntityTooLarge,
+			expectBody: "Request Entity Too Large",
+		},
+		{
+			name:          "chunked body exceeds the limit",
+			body:          strings.Repeat("a", 11),
+			chunked:       true,
+			expectCode:    nethttp.StatusOK,
+			expectReadErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(nethttp.MethodPost, "/", strings.NewReader(test.body))
+		req.Header.Set("Accept", test.accept)
+		if test.chunked {
+			req.ContentLength = -1
+		}
+
+		var readErr error
+		http.NewNetHttpContext(w, req, nil, []contractshttp.HandlerFunc{
+			contractshttp.HandlerFunc(MaxBodySize(10)),
+			func(ctx contractshttp.Context) {
+				var body []byte
+				body, readErr = io.ReadAll(ctx.Request().Origin().Body)
+				if readErr == nil {
+					ctx.Response().String(nethttp.StatusOK, string(body))
+				}
+			},
+		}).Next()
+
+		assert.Equal(t, test.expectCode, w.Code, test.name)
+		if test.expectBody != "" {
+			assert.Contains(t, w.Body.String(), test.expectBody, test.name)
+		}
+		assert.Equal(t, test.expectReadErr, readErr != nil, test.name)
+	}
+}
@@ -10,8 +10,10 @@ import (
 )
 
 const (
-	OpentracingTracer = "opentracing_tracer"
-	OpentracingCtx    = "opentracing_ctx"
+	// Deprecated: use http.OpentracingTracer of contracts/http.
+	OpentracingTracer = http.OpentracingTracer
+	// Deprecated: use http.OpentracingCtx of contracts/http.
+	OpentracingCtx = http.OpentracingCtx
 )
 
 func Opentracing(tracer opentracing.Tracer) http.Middleware {
@@ -32,8 +34,8 @@ func Opentracing(tracer opentracing.Tracer) http.Middleware {
 			defer parentSpan.Finish()
 		}
 
-		ctx.WithValue(OpentracingTracer, tracer)
-		ctx.WithValue(OpentracingCtx, opentracing.ContextWithSpan(context.Background(), parentSpan))
+		ctx.WithValue(http.OpentracingTracer, tracer)
+		ctx.WithValue(http.OpentracingCtx, opentracing.ContextWithSpan(context.Background(), parentSpan))
 		ctx.Request().Next()
 	}
 }
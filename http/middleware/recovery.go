@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	nethttp "net/http"
+	"runtime/debug"
+
+	"github.com/goravel/framework/contracts/foundation"
+	contractshttp "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/facades"
+	"github.com/goravel/framework/http"
+)
+
+// Recovery Recover from the panics of the handlers, the panic is reported and rendered by facades.ExceptionHandler.
+func Recovery() contractshttp.Middleware {
+	return func(ctx contractshttp.Context) {
+		defer func() {
+			if value := recover(); value != nil {
+				handler := exceptionHandler()
+				err := http.NewPanicError(value, debug.Stack())
+				handler.Report(ctx, err)
+
+				// The error can't be rendered if the response has been sent, e.g. in streaming, the rest handlers are aborted only.
+				if writer, ok := ctx.Response().Writer().(interface{ Written() bool }); ok && writer.Written() {
+					ctx.Request().AbortWithStatus(nethttp.StatusInternalServerError)
+
+					return
+				}
+
+				handler.Render(ctx, err)
+			}
+		}()
+
+		ctx.Request().Next()
+	}
+}
+
+func exceptionHandler() foundation.ExceptionHandler {
+	if facades.ExceptionHandler != nil {
+		return facades.ExceptionHandler
+	}
+
+	return http.NewExceptionHandler()
+}
@@ -0,0 +1,106 @@
+package middleware
+
+import (
+	"errors"
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/facades"
+	"github.com/goravel/framework/http"
+	"github.com/goravel/framework/testing/mock"
+)
+
+func TestRecovery(t *testing.T) {
+	var errorDatabase = errors.New("database is down")
+
+	tests := []struct {
+		name           string
+		debug          bool
+		accept         string
+		panic          interface{}
+		write          bool
+		expectCode     int
+		expectBody     string
+		expectContains []string
+	}{
+		{
+			name:       "json",
+			accept:     "application/json",
+			panic:      errorDatabase,
+			expectCode: nethttp.StatusInternalServerError,
+			expectBody: `{"message":"Internal Server Error"}`,
+		},
+		{
+			name:           "json in debug",
+			debug:          true,
+			accept:         "application/json",
+			panic:          errorDatabase,
+			expectCode:     nethttp.StatusInternalServerError,
+			expectContains: []string{`"message":"database is down"`, `"exception":"*errors.errorString"`, `"trace":[`},
+		},
+		{
+			name:           "html",
+			panic:          "boom",
+			expectCode:     nethttp.StatusInternalServerError,
+			expectContains: []string{"<h1>500 | Internal Server Error</h1>"},
+		},
+		{
+			name:           "html in debug",
+			debug:          true,
+			panic:          "boom",
+			expectCode:     nethttp.StatusInternalServerError,
+			expectContains: []string{"<h1>500 | panic: boom</h1>", "<pre>goroutine"},
+		},
+		{
+			name:       "http error",
+			accept:     "application/json",
+			panic:      http.NewHttpError(nethttp.StatusNotFound),
+			expectCode: nethttp.StatusNotFound,
+			expectBody: `{"message":"Not Found"}`,
+		},
+		{
+			name:       "response has been written",
+			panic:      "boom",
+			write:      true,
+			expectCode: nethttp.StatusOK,
+			expectBody: "partial",
+		},
+	}
+
+	for _, test := range tests {
+		mockConfig := mock.Config()
+		mockConfig.On("GetBool", "app.debug").Return(test.debug)
+		mock.Log()
+		facades.ExceptionHandler = http.NewExceptionHandler()
+
+		executed := false
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(nethttp.MethodGet, "/", nil)
+		req.Header.Set("Accept", test.accept)
+		http.NewNetHttpContext(w, req, nil, []contractshttp.HandlerFunc{
+			contractshttp.HandlerFunc(Recovery()),
+			func(ctx contractshttp.Context) {
+				if test.write {
+					ctx.Response().String(nethttp.StatusOK, "partial")
+				}
+				panic(test.panic)
+			},
+			func(ctx contractshttp.Context) {
+				executed = true
+			},
+		}).Next()
+
+		assert.False(t, executed, test.name)
+		assert.Equal(t, test.expectCode, w.Code, test.name)
+		if test.expectBody != "" {
+			assert.Equal(t, test.expectBody, w.Body.String(), test.name)
+		}
+		for _, contains := range test.expectContains {
+			assert.Contains(t, w.Body.String(), contains, test.name)
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"regexp"
+
+	"github.com/google/uuid"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+)
+
+// requestIdPattern The request ids sent by the clients are trusted only if they match it, so the logs can't be forged.
+var requestIdPattern = regexp.MustCompile(`^[A-Za-z0-9\-_.:]{1,128}$`)
+
+// RequestId Read the X-Request-Id header of the request or create a new id, the id is added to the context with the
+// http.RequestIdKey key and to the X-Request-Id header of the response. facades.Log.WithContext(ctx) adds it to the entries.
+func RequestId() contractshttp.Middleware {
+	return func(ctx contractshttp.Context) {
+		requestId := ctx.Request().Header("X-Request-Id", "")
+		if !requestIdPattern.MatchString(requestId) {
+			requestId = uuid.NewString()
+		}
+
+		ctx.WithValue(contractshttp.RequestIdKey, requestId)
+		ctx.Response().Header("X-Request-Id", requestId)
+		ctx.Request().Next()
+	}
+}
@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/http"
+)
+
+func TestRequestId(t *testing.T) {
+	tests := []struct {
+		name          string
+		header        string
+		expectCreated bool
+	}{
+		{name: "use the id of the request", header: "a1b2-c3d4"},
+		{name: "create an id if the request hasn't one", expectCreated: true},
+		{name: "create an id if the id of the request is invalid", header: "id\nforged", expectCreated: true},
+	}
+
+	for _, test := range tests {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(nethttp.MethodGet, "/", nil)
+		if test.header != "" {
+			req.Header.Set("X-Request-Id", test.header)
+		}
+
+		var requestId interface{}
+		http.NewNetHttpContext(w, req, nil, []contractshttp.HandlerFunc{
+			contractshttp.HandlerFunc(RequestId()),
+			func(ctx contractshttp.Context) {
+				requestId = ctx.Value(contractshttp.RequestIdKey)
+			},
+		}).Next()
+
+		responseId := w.Header().Get("X-Request-Id")
+		assert.Equal(t, responseId, requestId, test.name)
+		if test.expectCreated {
+			assert.Len(t, responseId, 36, test.name)
+		} else {
+			assert.Equal(t, test.header, responseId, test.name)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package middleware
+
+import (
+	"bufio"
+	"errors"
+	"math/rand"
+	"net"
+	nethttp "net/http"
+	"sync"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	contractssession "github.com/goravel/framework/contracts/session"
+	"github.com/goravel/framework/facades"
+	"github.com/goravel/framework/session"
+)
+
+// StartSession Start the session of the request, the session is saved before the response is written.
+func StartSession() contractshttp.Middleware {
+	return func(ctx contractshttp.Context) {
+		if ctx.Request().Session() != nil {
+			ctx.Request().Next()
+
+			return
+		}
+
+		driver, err := facades.Session.Driver()
+		if err != nil {
+			facades.Log.Error(err.Error())
+			ctx.Request().Next()
+
+			return
+		}
+
+		writer := ctx.Response().Writer()
+		request := ctx.Request().Origin()
+		if requestDriver, ok := driver.(contractssession.RequestDriver); ok {
+			driver = requestDriver.WithRequest(writer, request)
+		}
+
+		name := facades.Config.GetString("session.cookie", "goravel_session")
+		var id string
+		if cookie, err := request.Cookie(name); err == nil {
+			id = cookie.Value
+		}
+
+		s := facades.Session.BuildSession(driver, id)
+		s.Start()
+		ctx.Request().SetSession(s)
+
+		collectGarbage(driver)
+
+		var once sync.Once
+		save := func() {
+			once.Do(func() {
+				if err := s.Save(); err != nil {
+					facades.Log.Error(err.Error())
+				}
+
+				maxAge := 0
+				if !facades.Config.GetBool("session.expire_on_close") {
+					maxAge = facades.Config.GetInt("session.lifetime", 120) * 60
+				}
+				nethttp.SetCookie(writer, session.NewCookie(s.GetName(), s.GetID(), maxAge))
+			})
+		}
+
+		ctx.Response().SetWriter(&sessionWriter{ResponseWriter: writer, save: save})
+		ctx.Request().Next()
+		save()
+	}
+}
+
+// collectGarbage Remove the expired sessions by the lottery of session.lottery, the default is 2 in 100.
+func collectGarbage(driver contractssession.Driver) {
+	lottery, ok := facades.Config.Get("session.lottery", []int{2, 100}).([]int)
+	if !ok || len(lottery) != 2 || lottery[1] <= 0 {
+		return
+	}
+
+	if rand.Intn(lottery[1]) < lottery[0] {
+		if err := driver.Gc(facades.Config.GetInt("session.lifetime", 120) * 60); err != nil {
+			facades.Log.Error(err.Error())
+		}
+	}
+}
+
+// sessionWriter Save the session before the headers of the response are written.
+type sessionWriter struct {
+	nethttp.ResponseWriter
+	save func()
+}
+
+func (w *sessionWriter) WriteHeader(code int) {
+	w.save()
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *sessionWriter) Write(data []byte) (int, error) {
+	w.save()
+
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *sessionWriter) Flush() {
+	w.save()
+	if flusher, ok := w.ResponseWriter.(nethttp.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (w *sessionWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	w.save()
+	hijacker, ok := w.ResponseWriter.(nethttp.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("the response writer doesn't implement http.Hijacker")
+	}
+
+	return hijacker.Hijack()
+}
@@ -0,0 +1,66 @@
+package middleware
+
+import (
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/facades"
+	"github.com/goravel/framework/http"
+	"github.com/goravel/framework/session"
+	"github.com/goravel/framework/testing/mock"
+)
+
+func TestStartSession(t *testing.T) {
+	mockConfig := mock.Config()
+	mockConfig.On("GetString", "session.driver").Return("file")
+	mockConfig.On("GetInt", "session.lifetime", 120).Return(120)
+	mockConfig.On("GetString", "session.files", "storage/framework/sessions").Return(t.TempDir())
+	mockConfig.On("GetString", "session.cookie", "goravel_session").Return("goravel_session")
+	mockConfig.On("Get", "session.lottery", []int{2, 100}).Return([]int{0, 100})
+	mockConfig.On("GetBool", "session.expire_on_close").Return(false)
+	mockConfig.On("GetString", "session.path", "/").Return("/")
+	mockConfig.On("GetString", "session.domain").Return("")
+	mockConfig.On("GetBool", "session.secure").Return(false)
+	mockConfig.On("GetBool", "session.http_only", true).Return(true)
+	mockConfig.On("GetString", "session.same_site", "lax").Return("lax")
+	facades.Session = session.NewManager()
+
+	serve := func(req *nethttp.Request, handler contractshttp.HandlerFunc) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		http.NewNetHttpContext(w, req, nil, []contractshttp.HandlerFunc{
+			contractshttp.HandlerFunc(StartSession()),
+			handler,
+		}).Next()
+
+		return w
+	}
+
+	w := serve(httptest.NewRequest(nethttp.MethodGet, "/", nil), func(ctx contractshttp.Context) {
+		ctx.Request().Session().Put("name", "goravel").Flash("status", "created")
+		ctx.Response().String(nethttp.StatusOK, "ok")
+	})
+	cookies := w.Result().Cookies()
+	assert.Len(t, cookies, 1)
+	assert.Equal(t, "goravel_session", cookies[0].Name)
+	assert.Equal(t, 7200, cookies[0].MaxAge)
+
+	req := httptest.NewRequest(nethttp.MethodGet, "/", nil)
+	req.AddCookie(cookies[0])
+	w = serve(req, func(ctx contractshttp.Context) {
+		assert.Equal(t, cookies[0].Value, ctx.Request().Session().GetID())
+		assert.Equal(t, "goravel", ctx.Request().Session().Get("name"))
+		assert.Equal(t, "created", ctx.Request().Session().Get("status"))
+	})
+	assert.Equal(t, cookies[0].Value, w.Result().Cookies()[0].Value)
+
+	req = httptest.NewRequest(nethttp.MethodGet, "/", nil)
+	req.AddCookie(cookies[0])
+	serve(req, func(ctx contractshttp.Context) {
+		assert.Equal(t, "goravel", ctx.Request().Session().Get("name"))
+		assert.False(t, ctx.Request().Session().Has("status"))
+	})
+}
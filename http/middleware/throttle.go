@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	nethttp "net/http"
+	"strconv"
+	"time"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/facades"
+)
+
+// Throttle Limit the requests by the named limiter registered via facades.RateLimiter.For.
+func Throttle(name string) contractshttp.Middleware {
+	return func(ctx contractshttp.Context) {
+		limiter := facades.RateLimiter.Limiter(name)
+		if limiter == nil {
+			ctx.Request().Next()
+
+			return
+		}
+
+		limit := limiter(ctx)
+		if limit == nil {
+			ctx.Request().Next()
+
+			return
+		}
+
+		key := limit.GetKey()
+		if key == "" {
+			key = ctx.Request().Ip()
+		}
+		key = throttleKey(name, key)
+
+		maxAttempts := limit.GetMaxAttempts()
+		if facades.RateLimiter.TooManyAttempts(key, maxAttempts) {
+			retryAfter := facades.RateLimiter.AvailableIn(key)
+			ctx.Response().Header("X-RateLimit-Limit", strconv.Itoa(maxAttempts))
+			ctx.Response().Header("X-RateLimit-Remaining", "0")
+			ctx.Response().Header("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(retryAfter).Unix(), 10))
+			ctx.Response().Header("Retry-After", strconv.Itoa(int((retryAfter+time.Second-1)/time.Second)))
+
+			// The status is written by the custom response, so it isn't written again.
+			if response := limit.GetResponse(); response != nil {
+				response(ctx)
+				ctx.Request().Abort()
+			} else {
+				ctx.Request().AbortWithStatus(nethttp.StatusTooManyRequests)
+			}
+
+			return
+		}
+
+		facades.RateLimiter.Hit(key, limit.GetDecay())
+		ctx.Response().Header("X-RateLimit-Limit", strconv.Itoa(maxAttempts))
+		ctx.Response().Header("X-RateLimit-Remaining", strconv.Itoa(facades.RateLimiter.RemainingAttempts(key, maxAttempts)))
+
+		ctx.Request().Next()
+	}
+}
+
+func throttleKey(name, key string) string {
+	hash := md5.Sum([]byte(name + ":" + key))
+
+	return "throttle:" + hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,99 @@
+package middleware
+
+import (
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/http"
+	"github.com/goravel/framework/http/limit"
+	"github.com/goravel/framework/testing/mock"
+)
+
+func TestThrottle(t *testing.T) {
+	key := throttleKey("api", "goravel")
+	apiLimiter := func(ctx contractshttp.Context) contractshttp.Limit {
+		return limit.PerMinute(2).By("goravel")
+	}
+
+	tests := []struct {
+		name         string
+		setup        func()
+		expectCode   int
+		expectBody   string
+		expectHeader map[string]string
+	}{
+		{
+			name: "not limited",
+			setup: func() {
+				mockRateLimiter := mock.RateLimiter()
+				mockRateLimiter.On("Limiter", "api").Return(apiLimiter).Once()
+				mockRateLimiter.On("TooManyAttempts", key, 2).Return(false).Once()
+				mockRateLimiter.On("Hit", key, time.Minute).Return(1).Once()
+				mockRateLimiter.On("RemainingAttempts", key, 2).Return(1).Once()
+			},
+			expectCode:   200,
+			expectBody:   "ok",
+			expectHeader: map[string]string{"X-RateLimit-Limit": "2", "X-RateLimit-Remaining": "1"},
+		},
+		{
+			name: "limited",
+			setup: func() {
+				mockRateLimiter := mock.RateLimiter()
+				mockRateLimiter.On("Limiter", "api").Return(apiLimiter).Once()
+				mockRateLimiter.On("TooManyAttempts", key, 2).Return(true).Once()
+				mockRateLimiter.On("AvailableIn", key).Return(30 * time.Second).Once()
+			},
+			expectCode:   429,
+			expectHeader: map[string]string{"X-RateLimit-Limit": "2", "X-RateLimit-Remaining": "0", "Retry-After": "30"},
+		},
+		{
+			name: "limited with a custom response",
+			setup: func() {
+				mockRateLimiter := mock.RateLimiter()
+				mockRateLimiter.On("Limiter", "api").Return(func(ctx contractshttp.Context) contractshttp.Limit {
+					return limit.PerMinute(2).By("goravel").Response(func(ctx contractshttp.Context) {
+						ctx.Response().String(nethttp.StatusServiceUnavailable, "slow down")
+					})
+				}).Once()
+				mockRateLimiter.On("TooManyAttempts", key, 2).Return(true).Once()
+				mockRateLimiter.On("AvailableIn", key).Return(30 * time.Second).Once()
+			},
+			expectCode:   503,
+			expectBody:   "slow down",
+			expectHeader: map[string]string{"Retry-After": "30"},
+		},
+		{
+			name: "limiter doesn't exist",
+			setup: func() {
+				mockRateLimiter := mock.RateLimiter()
+				mockRateLimiter.On("Limiter", "api").Return(nil).Once()
+			},
+			expectCode: 200,
+			expectBody: "ok",
+		},
+	}
+
+	for _, test := range tests {
+		test.setup()
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(nethttp.MethodGet, "/", nil)
+		http.NewNetHttpContext(w, req, nil, []contractshttp.HandlerFunc{
+			contractshttp.HandlerFunc(Throttle("api")),
+			func(ctx contractshttp.Context) {
+				ctx.Response().String(nethttp.StatusOK, "ok")
+			},
+		}).Next()
+
+		assert.Equal(t, test.expectCode, w.Code, test.name)
+		assert.Equal(t, test.expectBody, w.Body.String(), test.name)
+		for key, value := range test.expectHeader {
+			assert.Equal(t, value, w.Header().Get(key), test.name)
+		}
+	}
+}
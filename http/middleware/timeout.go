@@ -0,0 +1,36 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	nethttp "net/http"
+	"time"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/http"
+)
+
+// Timeout Cancel the context of the request after the timeout, the handlers should stop when ctx.Done() is closed,
+// and the context is passed to facades.Orm, facades.Http, etc. A 503 http.HttpError is rendered by facades.ExceptionHandler
+// if nothing is written after the timeout.
+func Timeout(timeout time.Duration) contractshttp.Middleware {
+	return func(ctx contractshttp.Context) {
+		timeoutCtx, cancel := context.WithTimeout(ctx.Request().Origin().Context(), timeout)
+		defer cancel()
+
+		// A derived request is set on the context, the request of the server isn't modified. It isn't restored after
+		// the handlers, since they may still read it from other goroutines.
+		ctx.WithContext(timeoutCtx)
+
+		ctx.Request().Next()
+
+		if !errors.Is(timeoutCtx.Err(), context.DeadlineExceeded) {
+			return
+		}
+		if writer, ok := ctx.Response().Writer().(interface{ Written() bool }); ok && writer.Written() {
+			return
+		}
+
+		exceptionHandler().Render(ctx, http.NewHttpError(nethttp.StatusServiceUnavailable, "Request timeout."))
+	}
+}
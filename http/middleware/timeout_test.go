@@ -0,0 +1,87 @@
+package middleware
+
+import (
+	nethttp "net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/http"
+	"github.com/goravel/framework/testing/mock"
+)
+
+func TestTimeout(t *testing.T) {
+	mockConfig := mock.Config()
+	mockConfig.On("GetBool", "app.debug").Return(false)
+
+	var wg sync.WaitGroup
+	tests := []struct {
+		name       string
+		handler    contractshttp.HandlerFunc
+		expectCode int
+		expectBody string
+	}{
+		{
+			name: "handled within the timeout",
+			handler: func(ctx contractshttp.Context) {
+				ctx.Response().String(nethttp.StatusOK, "goravel")
+			},
+			expectCode: nethttp.StatusOK,
+			expectBody: "goravel",
+		},
+		{
+			name: "the context is canceled after the timeout",
+			handler: func(ctx contractshttp.Context) {
+				select {
+				case <-ctx.Done():
+				case <-time.After(time.Second):
+					ctx.Response().String(nethttp.StatusOK, "goravel")
+				}
+			},
+			expectCode: nethttp.StatusServiceUnavailable,
+			expectBody: `{"message":"Service Unavailable"}`,
+		},
+		{
+			name: "the response written after the timeout is kept",
+			handler: func(ctx contractshttp.Context) {
+				<-ctx.Done()
+				ctx.Response().String(nethttp.StatusGatewayTimeout, ctx.Err().Error())
+			},
+			expectCode: nethttp.StatusGatewayTimeout,
+			expectBody: "context deadline exceeded",
+		},
+		{
+			name: "the request is read by a goroutine after the handlers",
+			handler: func(ctx contractshttp.Context) {
+				request := ctx.Request().Origin()
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					<-request.Context().Done()
+				}()
+				ctx.Response().String(nethttp.StatusOK, "goravel")
+			},
+			expectCode: nethttp.StatusOK,
+			expectBody: "goravel",
+		},
+	}
+
+	for _, test := range tests {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(nethttp.MethodGet, "/", nil)
+		req.Header.Set("Accept", "application/json")
+		http.NewNetHttpContext(w, req, nil, []contractshttp.HandlerFunc{
+			contractshttp.HandlerFunc(Timeout(10 * time.Millisecond)),
+			test.handler,
+		}).Next()
+		wg.Wait()
+
+		assert.Equal(t, test.expectCode, w.Code, test.name)
+		assert.Equal(t, test.expectBody, w.Body.String(), test.name)
+		assert.Nil(t, req.Context().Err(), test.name)
+	}
+}
@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"errors"
+	nethttp "net/http"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/facades"
+	"github.com/goravel/framework/http"
+	"github.com/goravel/framework/support/crypt"
+)
+
+// ValidateSignature Reject the request if its url isn't generated by Route.Signed or Route.TemporarySigned,
+// or it has expired. The query string can't be changed, the parameters are signed as well. The urls signed with
+// the host, like the ones of the routes with a domain, are only valid on the host. The rejection is rendered by
+// facades.ExceptionHandler with a 403 http.HttpError.
+func ValidateSignature() contractshttp.Middleware {
+	return func(ctx contractshttp.Context) {
+		key := facades.Config.GetString("app.key")
+		request := ctx.Request().Origin()
+
+		u := *request.URL
+		u.Host = request.Host
+		err := crypt.VerifyUrl(key, &u)
+		if errors.Is(err, crypt.ErrorInvalidSignature) {
+			u.Host = ""
+			err = crypt.VerifyUrl(key, &u)
+		}
+		if err == nil {
+			ctx.Request().Next()
+
+			return
+		}
+
+		message := "Invalid signature."
+		if errors.Is(err, crypt.ErrorExpiredSignature) {
+			message = "Signature has expired."
+		}
+		exceptionHandler().Render(ctx, http.NewHttpError(nethttp.StatusForbidden, message))
+	}
+}
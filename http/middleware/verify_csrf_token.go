@@ -0,0 +1,118 @@
+package middleware
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	nethttp "net/http"
+	"regexp"
+	"strings"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/facades"
+	"github.com/goravel/framework/http"
+)
+
+const (
+	csrfTokenKey    = "GoravelCsrfToken"
+	xsrfTokenCookie = "XSRF-TOKEN"
+)
+
+// VerifyCsrfToken Verify the CSRF token of the unsafe requests, the token is stored in the session if
+// the StartSession middleware is used, otherwise it's stored in the encrypted XSRF-TOKEN cookie.
+// The mismatch is rendered by facades.ExceptionHandler with a 419 http.HttpError.
+// The excepts are the paths which skip the verification, * matches any characters: api/*
+func VerifyCsrfToken(excepts ...string) contractshttp.Middleware {
+	return func(ctx contractshttp.Context) {
+		token := csrfToken(ctx)
+		ctx.WithValue(csrfTokenKey, token)
+
+		if isReading(ctx.Request().Method()) || inExceptArray(excepts, ctx.Request().Path()) || tokensMatch(ctx, token) {
+			addXsrfCookie(ctx, token)
+			ctx.Request().Next()
+
+			return
+		}
+
+		exceptionHandler().Render(ctx, http.NewHttpError(419, "CSRF token mismatch."))
+	}
+}
+
+// CsrfToken Get the CSRF token of the request, it's used to render the _token field of forms.
+func CsrfToken(ctx contractshttp.Context) string {
+	token, _ := ctx.Value(csrfTokenKey).(string)
+
+	return token
+}
+
+func csrfToken(ctx contractshttp.Context) string {
+	if session := ctx.Request().Session(); session != nil {
+		return session.Token()
+	}
+
+	if token := ctx.Request().Cookie(xsrfTokenCookie); token != "" {
+		return token
+	}
+
+	bytes := make([]byte, 20)
+	if _, err := rand.Read(bytes); err != nil {
+		panic(err)
+	}
+
+	return hex.EncodeToString(bytes)
+}
+
+func isReading(method string) bool {
+	return method == nethttp.MethodGet || method == nethttp.MethodHead || method == nethttp.MethodOptions
+}
+
+func inExceptArray(excepts []string, path string) bool {
+	path = trimPath(path)
+	for _, except := range excepts {
+		pattern := "^" + strings.ReplaceAll(regexp.QuoteMeta(trimPath(except)), `\*`, ".*") + "$"
+		if matched, _ := regexp.MatchString(pattern, path); matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+func trimPath(path string) string {
+	if path = strings.Trim(path, "/"); path == "" {
+		return "/"
+	}
+
+	return path
+}
+
+func tokensMatch(ctx contractshttp.Context, token string) bool {
+	request := ctx.Request()
+	requestToken := request.Form("_token", "")
+	if requestToken == "" {
+		requestToken = request.Header("X-CSRF-TOKEN", "")
+	}
+	if requestToken == "" {
+		if header := request.Header("X-XSRF-TOKEN", ""); header != "" {
+			// The cookie isn't encrypted if it's in the cookie.except config.
+			var err error
+			if requestToken, err = http.DecryptCookie(xsrfTokenCookie, header); err != nil {
+				requestToken = header
+			}
+		}
+	}
+
+	return token != "" && requestToken != "" && subtle.ConstantTimeCompare([]byte(token), []byte(requestToken)) == 1
+}
+
+// addXsrfCookie The XSRF-TOKEN cookie can be read by JavaScript, and be sent back via the X-XSRF-TOKEN header.
+// The other attributes are taken from the cookie config.
+func addXsrfCookie(ctx contractshttp.Context, token string) {
+	readable := false
+	ctx.Response().Cookie(contractshttp.Cookie{
+		Name:     xsrfTokenCookie,
+		Value:    token,
+		MaxAge:   facades.Config.GetInt("session.lifetime", 120) * 60,
+		HttpOnly: &readable,
+	})
+}
@@ -0,0 +1,105 @@
+package middleware
+
+import (
+	nethttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/http"
+	"github.com/goravel/framework/session"
+	"github.com/goravel/framework/testing/mock"
+)
+
+func TestVerifyCsrfToken(t *testing.T) {
+	mockConfig := mock.Config()
+	mockConfig.On("GetString", "app.key").Return("12345678901234567890123456789012")
+	mockConfig.On("Get", "cookie.except", []string{}).Return([]string{})
+	mockConfig.On("GetString", "cookie.path", "/").Return("/")
+	mockConfig.On("GetString", "cookie.domain").Return("")
+	mockConfig.On("GetString", "cookie.same_site", "lax").Return("strict")
+	mockConfig.On("GetBool", "cookie.secure").Return(true)
+	mockConfig.On("GetBool", "cookie.http_only", true).Return(true)
+	mockConfig.On("GetInt", "session.lifetime", 120).Return(120)
+	mockConfig.On("GetBool", "app.debug").Return(false)
+
+	s := session.NewSession("goravel_session", session.NewFileDriver(t.TempDir(), 120), "")
+	s.Start()
+	xsrfToken, err := http.EncryptCookie(xsrfTokenCookie, s.Token())
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name           string
+		method         string
+		url            string
+		body           string
+		header         map[string]string
+		withoutSession bool
+		expectCode     int
+		expectBody     string
+	}{
+		{name: "safe method", method: "GET", url: "/users", expectCode: 200},
+		{name: "without token", method: "POST", url: "/users", header: map[string]string{"Accept": "application/json"}, expectCode: 419, expectBody: `{"message":"CSRF token mismatch."}`},
+		{name: "without token from a browser", method: "POST", url: "/users", header: map[string]string{"Accept": "text/html"}, expectCode: 419, expectBody: "CSRF token mismatch."},
+		{name: "invalid token", method: "POST", url: "/users", body: "_token=invalid", expectCode: 419},
+		{name: "form token", method: "POST", url: "/users", body: "_token=" + s.Token(), expectCode: 200},
+		{name: "csrf header", method: "PUT", url: "/users", header: map[string]string{"X-CSRF-TOKEN": s.Token()}, expectCode: 200},
+		{name: "xsrf header", method: "DELETE", url: "/users", header: map[string]string{"X-XSRF-TOKEN": xsrfToken}, expectCode: 200},
+		{name: "except", method: "POST", url: "/api/users", expectCode: 200},
+		{name: "cookie token", method: "POST", url: "/users", header: map[string]string{"X-XSRF-TOKEN": xsrfToken, "Cookie": xsrfTokenCookie + "=" + xsrfToken}, withoutSession: true, expectCode: 200},
+		{name: "cookie token mismatch", method: "POST", url: "/users", header: map[string]string{"X-XSRF-TOKEN": xsrfToken}, withoutSession: true, expectCode: 419},
+	}
+
+	for _, test := range tests {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(test.method, test.url, strings.NewReader(test.body))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		for key, value := range test.header {
+			req.Header.Set(key, value)
+		}
+
+		http.NewNetHttpContext(w, req, nil, []contractshttp.HandlerFunc{
+			func(ctx contractshttp.Context) {
+				if !test.withoutSession {
+					ctx.Request().SetSession(s)
+				}
+			},
+			contractshttp.HandlerFunc(VerifyCsrfToken("api/*")),
+			func(ctx contractshttp.Context) {
+				assert.NotEmpty(t, CsrfToken(ctx), test.name)
+				ctx.Response().String(nethttp.StatusOK, "ok")
+			},
+		}).Next()
+
+		assert.Equal(t, test.expectCode, w.Code, test.name)
+		if test.expectBody != "" {
+			assert.Contains(t, w.Body.String(), test.expectBody, test.name)
+		}
+		if test.expectCode == 200 {
+			cookies := w.Result().Cookies()
+			assert.Len(t, cookies, 1, test.name)
+			assert.Equal(t, xsrfTokenCookie, cookies[0].Name, test.name)
+			assert.False(t, cookies[0].HttpOnly, test.name)
+			assert.True(t, cookies[0].Secure, test.name)
+			assert.Equal(t, nethttp.SameSiteStrictMode, cookies[0].SameSite, test.name)
+			assert.Equal(t, 7200, cookies[0].MaxAge, test.name)
+			token, err := http.DecryptCookie(xsrfTokenCookie, cookies[0].Value)
+			assert.Nil(t, err, test.name)
+			if !test.withoutSession {
+				assert.Equal(t, s.Token(), token, test.name)
+			}
+		}
+	}
+}
+
+func TestInExceptArray(t *testing.T) {
+	assert.True(t, inExceptArray([]string{"/"}, "/"))
+	assert.True(t, inExceptArray([]string{"api/*"}, "/api/users/1"))
+	assert.True(t, inExceptArray([]string{"/webhooks/stripe/"}, "/webhooks/stripe"))
+	assert.False(t, inExceptArray([]string{"api/*"}, "/users"))
+	assert.False(t, inExceptArray([]string{"/"}, "/users"))
+	assert.False(t, inExceptArray(nil, "/users"))
+}
@@ -0,0 +1,215 @@
+package http
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v2"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+)
+
+// The formats returned by FormatOf.
+const (
+	FormatJson     = "json"
+	FormatXml      = "xml"
+	FormatYaml     = "yaml"
+	FormatProtoBuf = "protobuf"
+)
+
+// formats The MIME types of the formats, the first one is used as the Content-Type of the response.
+var formats = []struct {
+	name      string
+	mimeTypes []string
+}{
+	{name: FormatJson, mimeTypes: []string{"application/json"}},
+	{name: FormatXml, mimeTypes: []string{"application/xml", "text/xml"}},
+	{name: FormatYaml, mimeTypes: []string{"application/x-yaml", "application/yaml", "text/yaml"}},
+	{name: FormatProtoBuf, mimeTypes: []string{"application/x-protobuf", "application/protobuf"}},
+}
+
+// FormatOf Get the format of a Content-Type or an offer, it's empty if the format isn't supported.
+func FormatOf(contentType string) string {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return ""
+	}
+
+	for _, format := range formats {
+		for _, mimeType := range format.mimeTypes {
+			if mediaType == mimeType {
+				return format.name
+			}
+		}
+	}
+
+	return ""
+}
+
+// negotiateFormat Get the format accepted by the Accept header with the highest quality, JSON is preferred if the
+// qualities are the same. The protocol buffers is offered only if the obj is a proto.Message, and XML isn't offered
+// for the maps, like http.Json, encoding/xml can't marshal them.
+func negotiateFormat(accept string, obj interface{}) string {
+	if strings.TrimSpace(accept) == "" {
+		return FormatJson
+	}
+
+	type mediaRange struct {
+		mediaType string
+		quality   float64
+	}
+	var ranges []mediaRange
+	for _, item := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(item))
+		if err != nil {
+			continue
+		}
+
+		quality := 1.0
+		if q, exist := params["q"]; exist {
+			if parsed, err := strconv.ParseFloat(q, 64); err == nil {
+				quality = parsed
+			}
+		}
+		ranges = append(ranges, mediaRange{mediaType: mediaType, quality: quality})
+	}
+
+	var best string
+	var bestQuality float64
+	for _, format := range formats {
+		if _, ok := obj.(proto.Message); format.name == FormatProtoBuf && !ok {
+			continue
+		}
+		if format.name == FormatXml && !xmlMarshalable(obj) {
+			continue
+		}
+
+		for _, mimeType := range format.mimeTypes {
+			// The quality of the most specific range is used: text/xml, text/*, */*
+			quality, specificity := 0.0, -1
+			for _, item := range ranges {
+				current := -1
+				switch {
+				case item.mediaType == mimeType:
+					current = 2
+				case strings.HasSuffix(item.mediaType, "/*") && strings.HasPrefix(mimeType, strings.TrimSuffix(item.mediaType, "*")):
+					current = 1
+				case item.mediaType == "*/*":
+					current = 0
+				}
+				if current > specificity {
+					quality, specificity = item.quality, current
+				}
+			}
+
+			if quality > bestQuality {
+				best, bestQuality = format.name, quality
+			}
+		}
+	}
+
+	return best
+}
+
+// xmlMarshalable Determine if encoding/xml can marshal the obj, the maps and the slices of maps can't be marshaled.
+func xmlMarshalable(obj interface{}) bool {
+	t := reflect.TypeOf(obj)
+	for t != nil && (t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Array) {
+		t = t.Elem()
+	}
+
+	return t != nil && t.Kind() != reflect.Map
+}
+
+// WriteNegotiation Write the obj in the format negotiated by the Accept header of the request, 406 is responded
+// if none of the formats is accepted.
+func WriteNegotiation(response contractshttp.Response, request *http.Request, code int, obj interface{}) {
+	response.Header("Vary", "Accept")
+
+	switch negotiateFormat(request.Header.Get("Accept"), obj) {
+	case FormatJson:
+		response.Json(code, obj)
+	case FormatXml:
+		response.Xml(code, obj)
+	case FormatYaml:
+		response.Yaml(code, obj)
+	case FormatProtoBuf:
+		response.ProtoBuf(code, obj)
+	default:
+		response.String(http.StatusNotAcceptable, http.StatusText(http.StatusNotAcceptable))
+	}
+}
+
+func writeXml(w http.ResponseWriter, code int, obj interface{}) {
+	data, err := xml.Marshal(obj)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	w.WriteHeader(code)
+	_, _ = w.Write(data)
+}
+
+func writeYaml(w http.ResponseWriter, code int, obj interface{}) {
+	data, err := yaml.Marshal(obj)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-yaml; charset=utf-8")
+	w.WriteHeader(code)
+	_, _ = w.Write(data)
+}
+
+// WriteProtoBuf Write a proto.Message, 500 is responded if the obj isn't one.
+func WriteProtoBuf(w http.ResponseWriter, code int, obj interface{}) {
+	message, ok := obj.(proto.Message)
+	if !ok {
+		http.Error(w, "the object isn't a proto.Message", http.StatusInternalServerError)
+
+		return
+	}
+
+	data, err := proto.Marshal(message)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-protobuf")
+	w.WriteHeader(code)
+	_, _ = w.Write(data)
+}
+
+func decodeYaml(body io.Reader, obj interface{}) error {
+	return yaml.NewDecoder(body).Decode(obj)
+}
+
+// DecodeProtoBuf Decode the body into a proto.Message, the drivers use it to bind the protocol buffers.
+func DecodeProtoBuf(body io.Reader, obj interface{}) error {
+	message, ok := obj.(proto.Message)
+	if !ok {
+		return errors.New("the object isn't a proto.Message")
+	}
+
+	data, err := ioutil.ReadAll(body)
+	if err != nil {
+		return err
+	}
+
+	return proto.Unmarshal(data, message)
+}
@@ -0,0 +1,153 @@
+package http
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+)
+
+type Book struct {
+	Title string `json:"title" xml:"title" yaml:"title" form:"title"`
+}
+
+func TestNegotiateFormat(t *testing.T) {
+	message := wrapperspb.String("goravel")
+
+	tests := []struct {
+		accept string
+		obj    interface{}
+		expect string
+	}{
+		{accept: "", obj: Book{}, expect: FormatJson},
+		{accept: "*/*", obj: Book{}, expect: FormatJson},
+		{accept: "application/xml", obj: Book{}, expect: FormatXml},
+		{accept: "text/*", obj: Book{}, expect: FormatXml},
+		{accept: "application/json;q=0.5, application/x-yaml", obj: Book{}, expect: FormatYaml},
+		{accept: "text/html, application/xhtml+xml, application/xml;q=0.9, */*;q=0.8", obj: contractshttp.Json{"title": "Goravel"}, expect: FormatJson},
+		{accept: "application/xml", obj: []map[string]string{}, expect: ""},
+		{accept: "application/xml;q=0.9, application/x-yaml;q=0.5", obj: &Book{}, expect: FormatXml},
+		{accept: "application/x-protobuf", obj: Book{}, expect: ""},
+		{accept: "application/x-protobuf, application/json;q=0.1", obj: message, expect: FormatProtoBuf},
+		{accept: "text/html", obj: Book{}, expect: ""},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expect, negotiateFormat(test.accept, test.obj), test.accept)
+	}
+}
+
+func TestResponseFormats(t *testing.T) {
+	book := Book{Title: "Goravel"}
+	data, _ := proto.Marshal(wrapperspb.String("goravel"))
+
+	tests := []struct {
+		name              string
+		accept            string
+		write             func(response contractshttp.Response)
+		expectCode        int
+		expectContentType string
+		expectBody        string
+	}{
+		{
+			name:              "xml",
+			write:             func(response contractshttp.Response) { response.Xml(http.StatusOK, book) },
+			expectCode:        http.StatusOK,
+			expectContentType: "application/xml; charset=utf-8",
+			expectBody:        "<Book><title>Goravel</title></Book>",
+		},
+		{
+			name:              "yaml",
+			write:             func(response contractshttp.Response) { response.Yaml(http.StatusCreated, book) },
+			expectCode:        http.StatusCreated,
+			expectContentType: "application/x-yaml; charset=utf-8",
+			expectBody:        "title: Goravel\n",
+		},
+		{
+			name:              "protobuf",
+			write:             func(response contractshttp.Response) { response.ProtoBuf(http.StatusOK, wrapperspb.String("goravel")) },
+			expectCode:        http.StatusOK,
+			expectContentType: "application/x-protobuf",
+			expectBody:        string(data),
+		},
+		{
+			name:              "negotiate json",
+			write:             func(response contractshttp.Response) { response.Negotiate(http.StatusOK, book) },
+			expectCode:        http.StatusOK,
+			expectContentType: "application/json; charset=utf-8",
+			expectBody:        `{"title":"Goravel"}`,
+		},
+		{
+			name:              "negotiate xml",
+			accept:            "application/xml",
+			write:             func(response contractshttp.Response) { response.Negotiate(http.StatusOK, book) },
+			expectCode:        http.StatusOK,
+			expectContentType: "application/xml; charset=utf-8",
+			expectBody:        "<Book><title>Goravel</title></Book>",
+		},
+		{
+			name:   "negotiate http.Json from a browser",
+			accept: "text/html, application/xhtml+xml, application/xml;q=0.9, */*;q=0.8",
+			write: func(response contractshttp.Response) {
+				response.Negotiate(http.StatusOK, contractshttp.Json{"title": "Goravel"})
+			},
+			expectCode:        http.StatusOK,
+			expectContentType: "application/json; charset=utf-8",
+			expectBody:        `{"title":"Goravel"}`,
+		},
+		{
+			name:              "not acceptable",
+			accept:            "text/html",
+			write:             func(response contractshttp.Response) { response.Negotiate(http.StatusOK, book) },
+			expectCode:        http.StatusNotAcceptable,
+			expectContentType: "text/plain; charset=utf-8",
+			expectBody:        "Not Acceptable",
+		},
+	}
+
+	for _, test := range tests {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Accept", test.accept)
+		test.write(NewNetHttpContext(w, req, nil, nil).Response())
+
+		assert.Equal(t, test.expectCode, w.Code, test.name)
+		assert.Equal(t, test.expectContentType, w.Header().Get("Content-Type"), test.name)
+		assert.Equal(t, test.expectBody, w.Body.String(), test.name)
+	}
+}
+
+func TestBindFormats(t *testing.T) {
+	data, _ := proto.Marshal(wrapperspb.String("goravel"))
+
+	tests := []struct {
+		contentType string
+		body        []byte
+		obj         func() interface{}
+		expect      interface{}
+	}{
+		{contentType: "application/xml", body: []byte("<Book><title>Goravel</title></Book>"), obj: func() interface{} { return &Book{} }, expect: &Book{Title: "Goravel"}},
+		{contentType: "application/x-yaml", body: []byte("title: Goravel"), obj: func() interface{} { return &Book{} }, expect: &Book{Title: "Goravel"}},
+		{contentType: "application/yaml; charset=utf-8", body: []byte("title: Goravel"), obj: func() interface{} { return &Book{} }, expect: &Book{Title: "Goravel"}},
+		{contentType: "application/x-protobuf", body: data, obj: func() interface{} { return &wrapperspb.StringValue{} }, expect: "goravel"},
+	}
+
+	for _, test := range tests {
+		req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(test.body))
+		req.Header.Set("Content-Type", test.contentType)
+		obj := test.obj()
+
+		assert.Nil(t, NewNetHttpContext(httptest.NewRecorder(), req, nil, nil).Request().Bind(obj), test.contentType)
+		if message, ok := obj.(*wrapperspb.StringValue); ok {
+			assert.Equal(t, test.expect, message.GetValue(), test.contentType)
+		} else {
+			assert.Equal(t, test.expect, obj, test.contentType)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package http
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+)
+
+const abortIndex = math.MaxInt16
+
+// Background An empty http.Context, it's used out of the requests, e.g. the tests.
+func Background() contractshttp.Context {
+	return NewNetHttpContext(nil, &http.Request{}, nil, nil)
+}
+
+// NetHttpContext The http.Context of the net/http driver, the handlers of a route are executed as a chain via Next.
+type NetHttpContext struct {
+	writer   *ResponseWriter
+	request  *http.Request
+	params   map[string]string
+	handlers []contractshttp.HandlerFunc
+	index    int
+
+	mu   sync.RWMutex
+	keys map[string]interface{}
+}
+
+func NewNetHttpContext(w http.ResponseWriter, req *http.Request, params map[string]string, handlers []contractshttp.HandlerFunc) *NetHttpContext {
+	return &NetHttpContext{
+		writer:   NewResponseWriter(w),
+		request:  req,
+		params:   params,
+		handlers: handlers,
+		index:    -1,
+	}
+}
+
+func (c *NetHttpContext) Request() contractshttp.Request {
+	return NewNetHttpRequest(c)
+}
+
+func (c *NetHttpContext) Response() contractshttp.Response {
+	return NewNetHttpResponse(c)
+}
+
+func (c *NetHttpContext) WithValue(key string, value interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.keys == nil {
+		c.keys = make(map[string]interface{})
+	}
+	c.keys[key] = value
+}
+
+func (c *NetHttpContext) WithContext(ctx context.Context) {
+	c.request = c.request.WithContext(ctx)
+}
+
+func (c *NetHttpContext) Context() context.Context {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	ctx := context.Background()
+	for key, value := range c.keys {
+		ctx = context.WithValue(ctx, key, value)
+	}
+
+	return ctx
+}
+
+func (c *NetHttpContext) Deadline() (deadline time.Time, ok bool) {
+	return c.request.Context().Deadline()
+}
+
+func (c *NetHttpContext) Done() <-chan struct{} {
+	return c.request.Context().Done()
+}
+
+func (c *NetHttpContext) Err() error {
+	return c.request.Context().Err()
+}
+
+func (c *NetHttpContext) Value(key interface{}) interface{} {
+	if keyAsString, ok := key.(string); ok {
+		c.mu.RLock()
+		defer c.mu.RUnlock()
+
+		return c.keys[keyAsString]
+	}
+
+	return c.request.Context().Value(key)
+}
+
+// Next Execute the pending handlers in the chain.
+func (c *NetHttpContext) Next() {
+	c.index++
+	for c.index < len(c.handlers) {
+		c.handlers[c.index](c)
+		c.index++
+	}
+}
+
+// Abort Prevent the pending handlers from being called.
+func (c *NetHttpContext) Abort() {
+	c.index = abortIndex
+}
+
+func (c *NetHttpContext) IsAborted() bool {
+	return c.index >= abortIndex
+}
+
+func (c *NetHttpContext) Writer() *ResponseWriter {
+	return c.writer
+}
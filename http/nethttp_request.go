@@ -0,0 +1,232 @@
+package http
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+
+	contractsfilesystem "github.com/goravel/framework/contracts/filesystem"
+	contractshttp "github.com/goravel/framework/contracts/http"
+	contractssession "github.com/goravel/framework/contracts/session"
+	contractsvalidation "github.com/goravel/framework/contracts/validation"
+	"github.com/goravel/framework/filesystem"
+	"github.com/goravel/framework/validation"
+)
+
+const sessionKey = "GoravelSession"
+
+type NetHttpRequest struct {
+	ctx *NetHttpContext
+}
+
+func NewNetHttpRequest(ctx *NetHttpContext) contractshttp.Request {
+	return &NetHttpRequest{ctx: ctx}
+}
+
+func (r *NetHttpRequest) Input(key string) string {
+	return r.ctx.params[key]
+}
+
+func (r *NetHttpRequest) Query(key, defaultValue string) string {
+	if values, ok := r.ctx.request.URL.Query()[key]; ok && len(values) > 0 {
+		return values[0]
+	}
+
+	return defaultValue
+}
+
+func (r *NetHttpRequest) Form(key, defaultValue string) string {
+	r.parseForm()
+	if values, ok := r.ctx.request.PostForm[key]; ok && len(values) > 0 {
+		return values[0]
+	}
+
+	return defaultValue
+}
+
+func (r *NetHttpRequest) Cookie(name string, defaultValue ...string) string {
+	return GetCookie(r.ctx.request, name, defaultValue...)
+}
+
+func (r *NetHttpRequest) Bind(obj interface{}) error {
+	request := r.ctx.request
+	if request.Method == http.MethodGet {
+		return bindValues(request.URL.Query(), obj)
+	}
+
+	contentType := request.Header.Get("Content-Type")
+	format := FormatOf(contentType)
+	if (format != "" || strings.Contains(contentType, "xml")) && request.Body == nil {
+		return errors.New("invalid request")
+	}
+
+	switch {
+	case format == FormatJson || strings.Contains(contentType, "application/json"):
+		return json.NewDecoder(request.Body).Decode(obj)
+	case format == FormatYaml:
+		return decodeYaml(request.Body, obj)
+	case format == FormatProtoBuf:
+		return DecodeProtoBuf(request.Body, obj)
+	case strings.Contains(contentType, "xml"):
+		return xml.NewDecoder(request.Body).Decode(obj)
+	default:
+		r.parseForm()
+
+		return bindValues(request.Form, obj)
+	}
+}
+
+func (r *NetHttpRequest) File(name string) (contractsfilesystem.File, error) {
+	if err := r.ctx.request.ParseMultipartForm(defaultMultipartMemory); err != nil && !errors.Is(err, http.ErrNotMultipart) {
+		return nil, err
+	}
+
+	_, file, err := r.ctx.request.FormFile(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return filesystem.NewFileFromRequest(file)
+}
+
+func (r *NetHttpRequest) Files(name string) ([]contractsfilesystem.File, error) {
+	if err := r.ctx.request.ParseMultipartForm(defaultMultipartMemory); err != nil {
+		return nil, err
+	}
+
+	return FilesFromHeaders(r.ctx.request.MultipartForm.File[name])
+}
+
+func (r *NetHttpRequest) StreamFiles(handler func(name string, file contractsfilesystem.StreamedFile) error) error {
+	return StreamFiles(r.ctx.request, handler)
+}
+
+func (r *NetHttpRequest) Header(key, defaultValue string) string {
+	header := r.ctx.request.Header.Get(key)
+	if header != "" {
+		return header
+	}
+
+	return defaultValue
+}
+
+func (r *NetHttpRequest) Headers() http.Header {
+	return r.ctx.request.Header
+}
+
+func (r *NetHttpRequest) Method() string {
+	return r.ctx.request.Method
+}
+
+func (r *NetHttpRequest) Url() string {
+	return r.ctx.request.RequestURI
+}
+
+func (r *NetHttpRequest) FullUrl() string {
+	prefix := "https://"
+	if r.ctx.request.TLS == nil {
+		prefix = "http://"
+	}
+
+	if r.ctx.request.Host == "" {
+		return ""
+	}
+
+	return prefix + r.ctx.request.Host + r.ctx.request.RequestURI
+}
+
+func (r *NetHttpRequest) Abort() {
+	r.ctx.Abort()
+}
+
+func (r *NetHttpRequest) AbortWithStatus(code int) {
+	r.ctx.writer.WriteHeader(code)
+	r.ctx.Abort()
+}
+
+func (r *NetHttpRequest) AbortWithStatusJson(code int, jsonObj interface{}) {
+	r.ctx.Abort()
+	writeJson(r.ctx.writer, code, jsonObj)
+}
+
+func (r *NetHttpRequest) Next() {
+	r.ctx.Next()
+}
+
+func (r *NetHttpRequest) Path() string {
+	return r.ctx.request.URL.Path
+}
+
+func (r *NetHttpRequest) Ip() string {
+	for _, header := range []string{"X-Forwarded-For", "X-Real-Ip"} {
+		for _, item := range strings.Split(r.ctx.request.Header.Get(header), ",") {
+			if ip := net.ParseIP(strings.TrimSpace(item)); ip != nil {
+				return ip.String()
+			}
+		}
+	}
+
+	ip, _, err := net.SplitHostPort(strings.TrimSpace(r.ctx.request.RemoteAddr))
+	if err != nil {
+		return ""
+	}
+
+	return ip
+}
+
+func (r *NetHttpRequest) Origin() *http.Request {
+	return r.ctx.request
+}
+
+func (r *NetHttpRequest) Response() contractshttp.Response {
+	return NewNetHttpResponse(r.ctx)
+}
+
+func (r *NetHttpRequest) Session() contractssession.Session {
+	if session, ok := r.ctx.Value(sessionKey).(contractssession.Session); ok {
+		return session
+	}
+
+	return nil
+}
+
+func (r *NetHttpRequest) SetSession(session contractssession.Session) contractshttp.Request {
+	r.ctx.WithValue(sessionKey, session)
+
+	return r
+}
+
+func (r *NetHttpRequest) Validate(rules map[string]string, options ...contractsvalidation.Option) (contractsvalidation.Validator, error) {
+	return Validate(r.ctx.request, r.ctx.params, rules, options...)
+}
+
+func (r *NetHttpRequest) ValidateRequest(request contractshttp.FormRequest) (contractsvalidation.Errors, error) {
+	return ValidateRequest(r, request)
+}
+
+func (r *NetHttpRequest) parseForm() {
+	if strings.Contains(r.ctx.request.Header.Get("Content-Type"), "multipart/form-data") {
+		_ = r.ctx.request.ParseMultipartForm(defaultMultipartMemory)
+	} else {
+		_ = r.ctx.request.ParseForm()
+	}
+}
+
+// bindValues Bind form or query values to a struct, use the `form` tag to specify field names.
+func bindValues(values map[string][]string, obj interface{}) error {
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		TagName:          "form",
+		WeaklyTypedInput: true,
+		Result:           obj,
+	})
+	if err != nil {
+		return err
+	}
+
+	return decoder.Decode(validation.ValuesToMap(values))
+}
@@ -0,0 +1,202 @@
+package http
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+)
+
+type NetHttpResponse struct {
+	ctx *NetHttpContext
+}
+
+func NewNetHttpResponse(ctx *NetHttpContext) contractshttp.Response {
+	return &NetHttpResponse{ctx: ctx}
+}
+
+func (r *NetHttpResponse) String(code int, format string, values ...interface{}) {
+	writeString(r.ctx.writer, code, format, values...)
+}
+
+func (r *NetHttpResponse) Json(code int, obj interface{}) {
+	writeJson(r.ctx.writer, code, obj)
+}
+
+func (r *NetHttpResponse) Xml(code int, obj interface{}) {
+	writeXml(r.ctx.writer, code, obj)
+}
+
+func (r *NetHttpResponse) Yaml(code int, obj interface{}) {
+	writeYaml(r.ctx.writer, code, obj)
+}
+
+func (r *NetHttpResponse) ProtoBuf(code int, obj interface{}) {
+	WriteProtoBuf(r.ctx.writer, code, obj)
+}
+
+func (r *NetHttpResponse) Negotiate(code int, obj interface{}) {
+	WriteNegotiation(r, r.ctx.request, code, obj)
+}
+
+func (r *NetHttpResponse) File(filepath string) {
+	http.ServeFile(r.ctx.writer, r.ctx.request, filepath)
+}
+
+func (r *NetHttpResponse) Download(filepath, filename string) {
+	r.ctx.writer.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": filename}))
+	http.ServeFile(r.ctx.writer, r.ctx.request, filepath)
+}
+
+func (r *NetHttpResponse) View(view string, data interface{}) {
+	WriteView(r.ctx.writer, view, data)
+}
+
+func (r *NetHttpResponse) Stream(step func(w io.Writer) bool) {
+	WriteStream(r.ctx.request.Context().Done(), r.ctx.writer, step)
+}
+
+func (r *NetHttpResponse) SSE(events <-chan contractshttp.Event) {
+	WriteSSE(r.ctx.request.Context().Done(), r.ctx.writer, events)
+}
+
+func (r *NetHttpResponse) Success() contractshttp.ResponseSuccess {
+	return NewNetHttpSuccess(r.ctx)
+}
+
+func (r *NetHttpResponse) Header(key, value string) contractshttp.Response {
+	r.ctx.writer.Header().Set(key, value)
+
+	return r
+}
+
+func (r *NetHttpResponse) Cookie(cookie contractshttp.Cookie) contractshttp.Response {
+	SetCookie(r.ctx.writer, cookie)
+
+	return r
+}
+
+func (r *NetHttpResponse) WithoutCookie(name string) contractshttp.Response {
+	RemoveCookie(r.ctx.writer, name)
+
+	return r
+}
+
+func (r *NetHttpResponse) Writer() http.ResponseWriter {
+	return r.ctx.writer
+}
+
+func (r *NetHttpResponse) SetWriter(w http.ResponseWriter) {
+	writer := NewResponseWriter(w)
+	writer.status = r.ctx.writer.status
+	writer.size = r.ctx.writer.size
+	r.ctx.writer = writer
+}
+
+type NetHttpSuccess struct {
+	ctx *NetHttpContext
+}
+
+func NewNetHttpSuccess(ctx *NetHttpContext) contractshttp.ResponseSuccess {
+	return &NetHttpSuccess{ctx: ctx}
+}
+
+func (r *NetHttpSuccess) String(format string, values ...interface{}) {
+	writeString(r.ctx.writer, http.StatusOK, format, values...)
+}
+
+func (r *NetHttpSuccess) Json(obj interface{}) {
+	writeJson(r.ctx.writer, http.StatusOK, obj)
+}
+
+// ResponseWriter Record the status and size of the response, it's used by the net/http driver.
+type ResponseWriter struct {
+	http.ResponseWriter
+	status int
+	size   int
+}
+
+func NewResponseWriter(w http.ResponseWriter) *ResponseWriter {
+	return &ResponseWriter{ResponseWriter: w, status: http.StatusOK, size: -1}
+}
+
+func (w *ResponseWriter) WriteHeader(code int) {
+	if code > 0 && !w.Written() {
+		w.status = code
+		w.size = 0
+		w.ResponseWriter.WriteHeader(code)
+	}
+}
+
+func (w *ResponseWriter) Write(data []byte) (int, error) {
+	if !w.Written() {
+		w.WriteHeader(w.status)
+	}
+
+	n, err := w.ResponseWriter.Write(data)
+	w.size += n
+
+	return n, err
+}
+
+func (w *ResponseWriter) Status() int {
+	return w.status
+}
+
+func (w *ResponseWriter) Size() int {
+	return w.size
+}
+
+func (w *ResponseWriter) Written() bool {
+	return w.size != -1
+}
+
+func (w *ResponseWriter) Flush() {
+	if !w.Written() {
+		w.WriteHeader(w.status)
+	}
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (w *ResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("the response writer doesn't implement http.Hijacker")
+	}
+	if w.size < 0 {
+		w.size = 0
+	}
+
+	return hijacker.Hijack()
+}
+
+func writeString(w http.ResponseWriter, code int, format string, values ...interface{}) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(code)
+	if len(values) > 0 {
+		_, _ = fmt.Fprintf(w, format, values...)
+	} else {
+		_, _ = w.Write([]byte(format))
+	}
+}
+
+func writeJson(w http.ResponseWriter, code int, obj interface{}) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(code)
+	_, _ = w.Write(data)
+}
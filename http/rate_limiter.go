@@ -0,0 +1,120 @@
+package http
+
+import (
+	"sync"
+	"time"
+
+	"github.com/goravel/framework/contracts/cache"
+	contractshttp "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/facades"
+)
+
+// RateLimiter A fixed window rate limiter, the attempts are stored in facades.Cache.
+type RateLimiter struct {
+	mu       sync.RWMutex
+	limiters map[string]func(ctx contractshttp.Context) contractshttp.Limit
+}
+
+func NewRateLimiter() contractshttp.RateLimiter {
+	return &RateLimiter{
+		limiters: make(map[string]func(ctx contractshttp.Context) contractshttp.Limit),
+	}
+}
+
+func (r *RateLimiter) For(name string, callback func(ctx contractshttp.Context) contractshttp.Limit) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.limiters[name] = callback
+}
+
+func (r *RateLimiter) Limiter(name string) func(ctx contractshttp.Context) contractshttp.Limit {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.limiters[name]
+}
+
+func (r *RateLimiter) Attempt(key string, maxAttempts int, callback func(), decay time.Duration) bool {
+	if r.TooManyAttempts(key, maxAttempts) {
+		return false
+	}
+
+	callback()
+	r.Hit(key, decay)
+
+	return true
+}
+
+func (r *RateLimiter) TooManyAttempts(key string, maxAttempts int) bool {
+	if r.Attempts(key) >= maxAttempts {
+		if facades.Cache.Has(timerKey(key)) {
+			return true
+		}
+
+		r.Clear(key)
+	}
+
+	return false
+}
+
+func (r *RateLimiter) Hit(key string, decay time.Duration) int {
+	facades.Cache.Add(timerKey(key), time.Now().Add(decay).Unix(), decay)
+
+	added := facades.Cache.Add(key, 0, decay)
+	incrementer, ok := facades.Cache.(cache.Incrementer)
+	if !ok {
+		// The attempts aren't incremented atomically, they expire with the timer of the window.
+		hits := r.Attempts(key) + 1
+		ttl := r.AvailableIn(key)
+		if ttl <= 0 {
+			ttl = decay
+		}
+		_ = facades.Cache.Put(key, hits, ttl)
+
+		return hits
+	}
+
+	hits, err := incrementer.Increment(key)
+	if err != nil {
+		return 0
+	}
+
+	// The key expired between Add and Increment, it's created without an expiration time.
+	if !added && hits == 1 {
+		_ = facades.Cache.Put(key, 1, decay)
+	}
+
+	return hits
+}
+
+func (r *RateLimiter) Attempts(key string) int {
+	return facades.Cache.GetInt(key, 0)
+}
+
+func (r *RateLimiter) RemainingAttempts(key string, maxAttempts int) int {
+	remaining := maxAttempts - r.Attempts(key)
+	if remaining < 0 {
+		return 0
+	}
+
+	return remaining
+}
+
+func (r *RateLimiter) AvailableIn(key string) time.Duration {
+	availableIn := time.Until(time.Unix(int64(facades.Cache.GetInt(timerKey(key), 0)), 0))
+	if availableIn < 0 {
+		return 0
+	}
+
+	return availableIn
+}
+
+func (r *RateLimiter) Clear(key string) {
+	facades.Cache.Forget(key)
+	facades.Cache.Forget(timerKey(key))
+}
+
+func timerKey(key string) string {
+	return key + ":timer"
+}
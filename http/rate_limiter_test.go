@@ -0,0 +1,156 @@
+package http
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	testifymock "github.com/stretchr/testify/mock"
+
+	cachemocks "github.com/goravel/framework/contracts/cache/mocks"
+	contractshttp "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/facades"
+	"github.com/goravel/framework/http/limit"
+	"github.com/goravel/framework/testing/mock"
+)
+
+func TestRateLimiterFor(t *testing.T) {
+	rateLimiter := NewRateLimiter()
+	assert.Nil(t, rateLimiter.Limiter("api"))
+
+	rateLimiter.For("api", func(ctx contractshttp.Context) contractshttp.Limit {
+		return limit.PerMinute(60).By("goravel")
+	})
+	limiter := rateLimiter.Limiter("api")
+	assert.NotNil(t, limiter)
+
+	l := limiter(Background())
+	assert.Equal(t, "goravel", l.GetKey())
+	assert.Equal(t, 60, l.GetMaxAttempts())
+	assert.Equal(t, time.Minute, l.GetDecay())
+}
+
+// incrementerStore A store that implements the optional cache.Incrementer.
+type incrementerStore struct {
+	*cachemocks.Store
+	*cachemocks.Incrementer
+}
+
+func mockIncrementerCache() (*cachemocks.Store, *cachemocks.Incrementer) {
+	mockCache := &cachemocks.Store{}
+	mockIncrementer := &cachemocks.Incrementer{}
+	facades.Cache = &incrementerStore{Store: mockCache, Incrementer: mockIncrementer}
+
+	return mockCache, mockIncrementer
+}
+
+func TestRateLimiterHit(t *testing.T) {
+	mockCache, mockIncrementer := mockIncrementerCache()
+	mockCache.On("Add", "key:timer", testifymock.Anything, time.Minute).Return(true).Once()
+	mockCache.On("Add", "key", 0, time.Minute).Return(true).Once()
+	mockIncrementer.On("Increment", "key").Return(1, nil).Once()
+
+	assert.Equal(t, 1, NewRateLimiter().Hit("key", time.Minute))
+
+	mockCache.On("Add", "key:timer", testifymock.Anything, time.Minute).Return(false).Once()
+	mockCache.On("Add", "key", 0, time.Minute).Return(false).Once()
+	mockIncrementer.On("Increment", "key").Return(1, nil).Once()
+	mockCache.On("Put", "key", 1, time.Minute).Return(nil).Once()
+
+	assert.Equal(t, 1, NewRateLimiter().Hit("key", time.Minute))
+
+	mockCache.AssertExpectations(t)
+	mockIncrementer.AssertExpectations(t)
+}
+
+func TestRateLimiterHitWithoutIncrementer(t *testing.T) {
+	mockCache := mock.Cache()
+	mockCache.On("Add", "key:timer", testifymock.Anything, time.Minute).Return(false).Once()
+	mockCache.On("Add", "key", 0, time.Minute).Return(false).Once()
+	mockCache.On("GetInt", "key", 0).Return(2).Once()
+	mockCache.On("GetInt", "key:timer", 0).Return(int(time.Now().Add(30 * time.Second).Unix())).Once()
+	mockCache.On("Put", "key", 3, testifymock.MatchedBy(func(ttl time.Duration) bool {
+		return ttl > 28*time.Second && ttl <= 30*time.Second
+	})).Return(nil).Once()
+
+	assert.Equal(t, 3, NewRateLimiter().Hit("key", time.Minute))
+
+	mockCache.AssertExpectations(t)
+}
+
+func TestRateLimiterTooManyAttempts(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(mockCache *cachemocks.Store)
+		expected bool
+	}{
+		{
+			name: "under the limit",
+			setup: func(mockCache *cachemocks.Store) {
+				mockCache.On("GetInt", "key", 0).Return(1).Once()
+			},
+		},
+		{
+			name: "over the limit",
+			setup: func(mockCache *cachemocks.Store) {
+				mockCache.On("GetInt", "key", 0).Return(2).Once()
+				mockCache.On("Has", "key:timer").Return(true).Once()
+			},
+			expected: true,
+		},
+		{
+			name: "timer expired",
+			setup: func(mockCache *cachemocks.Store) {
+				mockCache.On("GetInt", "key", 0).Return(2).Once()
+				mockCache.On("Has", "key:timer").Return(false).Once()
+				mockCache.On("Forget", "key").Return(true).Once()
+				mockCache.On("Forget", "key:timer").Return(true).Once()
+			},
+		},
+	}
+
+	for _, test := range tests {
+		mockCache := mock.Cache()
+		test.setup(mockCache)
+		assert.Equal(t, test.expected, NewRateLimiter().TooManyAttempts("key", 2), test.name)
+		mockCache.AssertExpectations(t)
+	}
+}
+
+func TestRateLimiterAttempt(t *testing.T) {
+	mockCache, mockIncrementer := mockIncrementerCache()
+	mockCache.On("GetInt", "key", 0).Return(0).Once()
+	mockCache.On("Add", "key:timer", testifymock.Anything, time.Minute).Return(true).Once()
+	mockCache.On("Add", "key", 0, time.Minute).Return(true).Once()
+	mockIncrementer.On("Increment", "key").Return(1, nil).Once()
+
+	executed := false
+	assert.True(t, NewRateLimiter().Attempt("key", 1, func() {
+		executed = true
+	}, time.Minute))
+	assert.True(t, executed)
+
+	mockCache.On("GetInt", "key", 0).Return(1).Once()
+	mockCache.On("Has", "key:timer").Return(true).Once()
+	assert.False(t, NewRateLimiter().Attempt("key", 1, func() {
+		t.Fail()
+	}, time.Minute))
+
+	mockCache.AssertExpectations(t)
+	mockIncrementer.AssertExpectations(t)
+}
+
+func TestRateLimiterRemainingAttempts(t *testing.T) {
+	mockCache := mock.Cache()
+	mockCache.On("GetInt", "key", 0).Return(2).Once()
+	assert.Equal(t, 3, NewRateLimiter().RemainingAttempts("key", 5))
+
+	mockCache.On("GetInt", "key", 0).Return(6).Once()
+	assert.Equal(t, 0, NewRateLimiter().RemainingAttempts("key", 5))
+
+	mockCache.On("GetInt", "key:timer", 0).Return(int(time.Now().Add(time.Minute).Unix())).Once()
+	availableIn := NewRateLimiter().AvailableIn("key")
+	assert.True(t, availableIn > 58*time.Second && availableIn <= time.Minute)
+
+	mockCache.AssertExpectations(t)
+}
@@ -0,0 +1,337 @@
+package http
+
+import (
+	"net/url"
+	"reflect"
+	"strconv"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+)
+
+// Transformer Map a model into the fields of a response, the fields created by When and WhenLoaded of the resource
+// are removed if their conditions aren't met: {"id": user.ID, "email": resource.When(isAdmin, user.Email)}
+type Transformer func(resource *Resource, model interface{}) contractshttp.Json
+
+// missingValue The value of a field whose condition isn't met, the field is removed when the resource is resolved.
+type missingValue struct{}
+
+type Resource struct {
+	model     interface{}
+	transform Transformer
+	ctx       contractshttp.Context
+	meta      contractshttp.Json
+	links     contractshttp.Json
+}
+
+// NewResource Create a resource of a model, it's rendered as {"data": {...}} with the meta and links if they are added.
+func NewResource(model interface{}, transform Transformer) *Resource {
+	return &Resource{
+		model:     model,
+		transform: transform,
+	}
+}
+
+// Context Get the context of the request that the resource is resolved for, it's nil if there isn't a request.
+func (r *Resource) Context() contractshttp.Context {
+	return r.ctx
+}
+
+// When Get the value if the condition is true, otherwise the default value, the field is removed if there isn't
+// a default value. The value is evaluated lazily if it's a func() interface{}.
+func (r *Resource) When(condition bool, value interface{}, defaultValue ...interface{}) interface{} {
+	if condition {
+		return lazyValue(value)
+	}
+	if len(defaultValue) > 0 {
+		return lazyValue(defaultValue[0])
+	}
+
+	return missingValue{}
+}
+
+// WhenLoaded Get the value if the relation field of the model is loaded by Preload, the relation itself is used if
+// the value isn't given, otherwise the field is removed.
+func (r *Resource) WhenLoaded(relation string, value ...interface{}) interface{} {
+	field, loaded := loadedField(r.model, relation)
+	if !loaded {
+		return missingValue{}
+	}
+	if len(value) > 0 {
+		return lazyValue(value[0])
+	}
+
+	return field
+}
+
+// WithMeta Add the meta to the response of the resource.
+func (r *Resource) WithMeta(meta contractshttp.Json) *Resource {
+	r.meta = meta
+
+	return r
+}
+
+// WithLinks Add the links to the response of the resource.
+func (r *Resource) WithLinks(links contractshttp.Json) *Resource {
+	r.links = links
+
+	return r
+}
+
+// Resolve Get the response of the resource for the request, the context can be nil.
+func (r *Resource) Resolve(ctx contractshttp.Context) contractshttp.Json {
+	response := contractshttp.Json{"data": r.data(ctx)}
+	if len(r.meta) > 0 {
+		response["meta"] = resolveValue(ctx, r.meta)
+	}
+	if len(r.links) > 0 {
+		response["links"] = resolveValue(ctx, r.links)
+	}
+
+	return response
+}
+
+// Response Write the resolved resource as JSON.
+func (r *Resource) Response(ctx contractshttp.Context, code int) {
+	ctx.Response().Json(code, r.Resolve(ctx))
+}
+
+func (r *Resource) data(ctx contractshttp.Context) interface{} {
+	if r.model == nil || r.transform == nil {
+		return nil
+	}
+
+	r.ctx = ctx
+
+	return resolveValue(ctx, r.transform(r, r.model))
+}
+
+type ResourceCollection struct {
+	models     []interface{}
+	transform  Transformer
+	meta       contractshttp.Json
+	links      contractshttp.Json
+	pagination *pagination
+}
+
+type pagination struct {
+	page  int
+	limit int
+	total int64
+}
+
+// NewResourceCollection Create a resource collection of a slice of models, every model is mapped by the transformer.
+func NewResourceCollection(models interface{}, transform Transformer) *ResourceCollection {
+	collection := &ResourceCollection{transform: transform}
+
+	value := reflect.Indirect(reflect.ValueOf(models))
+	if value.Kind() == reflect.Slice || value.Kind() == reflect.Array {
+		for i := 0; i < value.Len(); i++ {
+			collection.models = append(collection.models, value.Index(i).Interface())
+		}
+	}
+
+	return collection
+}
+
+// Paginate Add the pagination of orm.Query.Paginate to the collection, it's rendered as
+// {"data": [...], "meta": {"current_page": 1, ...}, "links": {"first": "...", ...}}.
+func (r *ResourceCollection) Paginate(page, limit int, total int64) *ResourceCollection {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = 1
+	}
+	r.pagination = &pagination{page: page, limit: limit, total: total}
+
+	return r
+}
+
+// WithMeta Add the meta to the response of the collection, it's merged with the meta of the pagination.
+func (r *ResourceCollection) WithMeta(meta contractshttp.Json) *ResourceCollection {
+	r.meta = meta
+
+	return r
+}
+
+// WithLinks Add the links to the response of the collection, it's merged with the links of the pagination.
+func (r *ResourceCollection) WithLinks(links contractshttp.Json) *ResourceCollection {
+	r.links = links
+
+	return r
+}
+
+// Resolve Get the response of the collection for the request, the links of the pagination are built from the url
+// of the request, they are relative if the context is nil.
+func (r *ResourceCollection) Resolve(ctx contractshttp.Context) contractshttp.Json {
+	meta := contractshttp.Json{}
+	links := contractshttp.Json{}
+	if r.pagination != nil {
+		meta, links = r.paginate(ctx)
+	}
+	for key, value := range r.meta {
+		meta[key] = value
+	}
+	for key, value := range r.links {
+		links[key] = value
+	}
+
+	response := contractshttp.Json{"data": r.data(ctx)}
+	if len(meta) > 0 {
+		response["meta"] = resolveValue(ctx, meta)
+	}
+	if len(links) > 0 {
+		response["links"] = resolveValue(ctx, links)
+	}
+
+	return response
+}
+
+// Response Write the resolved collection as JSON.
+func (r *ResourceCollection) Response(ctx contractshttp.Context, code int) {
+	ctx.Response().Json(code, r.Resolve(ctx))
+}
+
+func (r *ResourceCollection) data(ctx contractshttp.Context) []interface{} {
+	data := make([]interface{}, 0, len(r.models))
+	for _, model := range r.models {
+		data = append(data, NewResource(model, r.transform).data(ctx))
+	}
+
+	return data
+}
+
+func (r *ResourceCollection) paginate(ctx contractshttp.Context) (contractshttp.Json, contractshttp.Json) {
+	page, limit, total := r.pagination.page, r.pagination.limit, r.pagination.total
+	lastPage := int((total + int64(limit) - 1) / int64(limit))
+	if lastPage < 1 {
+		lastPage = 1
+	}
+
+	meta := contractshttp.Json{
+		"current_page": page,
+		"per_page":     limit,
+		"total":        total,
+		"last_page":    lastPage,
+		"from":         nil,
+		"to":           nil,
+	}
+	if len(r.models) > 0 {
+		from := (page-1)*limit + 1
+		meta["from"] = from
+		meta["to"] = from + len(r.models) - 1
+	}
+
+	var rawUrl string
+	if ctx != nil {
+		rawUrl = ctx.Request().FullUrl()
+		if rawUrl == "" {
+			rawUrl = ctx.Request().Url()
+		}
+	}
+	links := contractshttp.Json{
+		"first": pageUrl(rawUrl, 1),
+		"last":  pageUrl(rawUrl, lastPage),
+		"prev":  nil,
+		"next":  nil,
+	}
+	if page > 1 {
+		links["prev"] = pageUrl(rawUrl, page-1)
+	}
+	if page < lastPage {
+		links["next"] = pageUrl(rawUrl, page+1)
+	}
+
+	return meta, links
+}
+
+// pageUrl Replace the page in the query string of the url, the other queries are kept: /users?sort=name&page=2
+func pageUrl(rawUrl string, page int) string {
+	u, err := url.Parse(rawUrl)
+	if err != nil {
+		u = &url.URL{}
+	}
+
+	query := u.Query()
+	query.Set("page", strconv.Itoa(page))
+	u.RawQuery = query.Encode()
+
+	return u.String()
+}
+
+// resolveValue Remove the missing fields and resolve the nested resources in the value.
+func resolveValue(ctx contractshttp.Context, value interface{}) interface{} {
+	switch value := value.(type) {
+	case *Resource:
+		return value.data(ctx)
+	case *ResourceCollection:
+		return value.data(ctx)
+	case contractshttp.Json:
+		return resolveMap(ctx, value)
+	case map[string]interface{}:
+		return map[string]interface{}(resolveMap(ctx, value))
+	case []interface{}:
+		items := make([]interface{}, 0, len(value))
+		for _, item := range value {
+			if _, missing := item.(missingValue); !missing {
+				items = append(items, resolveValue(ctx, item))
+			}
+		}
+
+		return items
+	default:
+		return value
+	}
+}
+
+func resolveMap(ctx contractshttp.Context, value map[string]interface{}) contractshttp.Json {
+	fields := make(contractshttp.Json, len(value))
+	for key, item := range value {
+		if _, missing := item.(missingValue); !missing {
+			fields[key] = resolveValue(ctx, item)
+		}
+	}
+
+	return fields
+}
+
+func lazyValue(value interface{}) interface{} {
+	if callback, ok := value.(func() interface{}); ok {
+		return callback()
+	}
+
+	return value
+}
+
+// loadedField Get the relation field of the model, it's loaded if it isn't nil or zero, gorm sets an empty slice
+// to the has many relations that are preloaded without records.
+func loadedField(model interface{}, relation string) (interface{}, bool) {
+	value := reflect.ValueOf(model)
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return nil, false
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return nil, false
+	}
+
+	field := value.FieldByName(relation)
+	if !field.IsValid() || !field.CanInterface() {
+		return nil, false
+	}
+
+	switch field.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Slice, reflect.Map:
+		if field.IsNil() {
+			return nil, false
+		}
+	default:
+		if field.IsZero() {
+			return nil, false
+		}
+	}
+
+	return field.Interface(), true
+}
@@ -0,0 +1,113 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+)
+
+type resourcePost struct {
+	ID    uint
+	Title string
+}
+
+type resourceUser struct {
+	ID    uint
+	Name  string
+	Email string
+	Posts []resourcePost
+}
+
+func postTransformer(resource *Resource, model interface{}) contractshttp.Json {
+	post := model.(resourcePost)
+
+	return contractshttp.Json{"id": post.ID, "title": post.Title}
+}
+
+func userTransformer(resource *Resource, model interface{}) contractshttp.Json {
+	user := model.(resourceUser)
+	isAdmin := resource.Context() != nil && resource.Context().Request().Query("admin", "") == "1"
+
+	return contractshttp.Json{
+		"id":    user.ID,
+		"name":  user.Name,
+		"email": resource.When(isAdmin, user.Email),
+		"role":  resource.When(isAdmin, "admin", "member"),
+		"posts": resource.WhenLoaded("Posts", func() interface{} {
+			return NewResourceCollection(user.Posts, postTransformer)
+		}),
+	}
+}
+
+func TestResource(t *testing.T) {
+	user := resourceUser{ID: 1, Name: "Goravel", Email: "hello@goravel.dev"}
+
+	ctx := NewNetHttpContext(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/users/1", nil), nil, nil)
+	assert.Equal(t, contractshttp.Json{
+		"data": contractshttp.Json{"id": uint(1), "name": "Goravel", "role": "member"},
+	}, NewResource(user, userTransformer).Resolve(ctx))
+
+	user.Posts = []resourcePost{}
+	ctx = NewNetHttpContext(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/users/1?admin=1", nil), nil, nil)
+	assert.Equal(t, contractshttp.Json{
+		"data": contractshttp.Json{
+			"id": uint(1), "name": "Goravel", "email": "hello@goravel.dev", "role": "admin", "posts": []interface{}{},
+		},
+		"meta":  contractshttp.Json{"version": "v1"},
+		"links": contractshttp.Json{"self": "/users/1"},
+	}, NewResource(user, userTransformer).
+		WithMeta(contractshttp.Json{"version": "v1"}).
+		WithLinks(contractshttp.Json{"self": "/users/1"}).
+		Resolve(ctx))
+}
+
+func TestResourceCollection(t *testing.T) {
+	users := []resourceUser{
+		{ID: 3, Name: "Goravel", Posts: []resourcePost{{ID: 1, Title: "Hello"}}},
+		{ID: 4, Name: "Gin"},
+	}
+
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/users?sort=name&page=2", nil)
+	ctx := NewNetHttpContext(recorder, request, nil, nil)
+	NewResourceCollection(users, userTransformer).
+		Paginate(2, 2, 5).
+		WithMeta(contractshttp.Json{"version": "v1"}).
+		Response(ctx, http.StatusOK)
+
+	var response map[string]interface{}
+	assert.Nil(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{
+			"id": float64(3), "name": "Goravel", "role": "member",
+			"posts": []interface{}{map[string]interface{}{"id": float64(1), "title": "Hello"}},
+		},
+		map[string]interface{}{"id": float64(4), "name": "Gin", "role": "member"},
+	}, response["data"])
+	assert.Equal(t, map[string]interface{}{
+		"current_page": float64(2), "per_page": float64(2), "total": float64(5), "last_page": float64(3),
+		"from": float64(3), "to": float64(4), "version": "v1",
+	}, response["meta"])
+	assert.Equal(t, map[string]interface{}{
+		"first": "http://example.com/users?page=1&sort=name",
+		"last":  "http://example.com/users?page=3&sort=name",
+		"prev":  "http://example.com/users?page=1&sort=name",
+		"next":  "http://example.com/users?page=3&sort=name",
+	}, response["links"])
+}
+
+func TestResourceCollectionWithoutPagination(t *testing.T) {
+	assert.Equal(t, contractshttp.Json{"data": []interface{}{}}, NewResourceCollection(nil, userTransformer).Resolve(nil))
+
+	response := NewResourceCollection([]resourcePost{}, postTransformer).Paginate(1, 15, 0).Resolve(nil)
+	assert.Equal(t, contractshttp.Json{
+		"current_page": 1, "per_page": 15, "total": int64(0), "last_page": 1, "from": nil, "to": nil,
+	}, response["meta"])
+	assert.Equal(t, contractshttp.Json{"first": "?page=1", "last": "?page=1", "prev": nil, "next": nil}, response["links"])
+}
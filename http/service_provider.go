@@ -1,10 +1,15 @@
 package http
 
+import (
+	"github.com/goravel/framework/facades"
+)
+
 type ServiceProvider struct {
 }
 
 func (database *ServiceProvider) Register() {
-
+	facades.RateLimiter = NewRateLimiter()
+	facades.ExceptionHandler = NewExceptionHandler()
 }
 
 func (database *ServiceProvider) Boot() {
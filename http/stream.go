@@ -0,0 +1,103 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/facades"
+)
+
+// WriteStream Call the step until it returns false or done is closed, the writer is flushed after each step.
+func WriteStream(done <-chan struct{}, w http.ResponseWriter, step func(w io.Writer) bool) {
+	for {
+		select {
+		case <-done:
+			return
+		default:
+			keepOpen := step(w)
+			flush(w)
+			if !keepOpen {
+				return
+			}
+		}
+	}
+}
+
+// WriteSSE Send the events until the channel is closed or done is closed, a keep-alive comment is sent
+// every http.sse_keep_alive seconds to prevent the connection from being closed by proxies.
+func WriteSSE(done <-chan struct{}, w http.ResponseWriter, events <-chan contractshttp.Event) {
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flush(w)
+
+	keepAlive := time.NewTicker(time.Duration(facades.Config.GetInt("http.sse_keep_alive", 15)) * time.Second)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			if err := writeEvent(w, event); err != nil {
+				return
+			}
+		case <-keepAlive.C:
+			if _, err := io.WriteString(w, ": keep-alive\n\n"); err != nil {
+				return
+			}
+		}
+		flush(w)
+	}
+}
+
+func writeEvent(w io.Writer, event contractshttp.Event) error {
+	var builder strings.Builder
+	if event.ID != "" {
+		builder.WriteString("id: " + event.ID + "\n")
+	}
+	if event.Event != "" {
+		builder.WriteString("event: " + event.Event + "\n")
+	}
+	if event.Retry > 0 {
+		builder.WriteString(fmt.Sprintf("retry: %d\n", event.Retry))
+	}
+
+	var data string
+	switch value := event.Data.(type) {
+	case string:
+		data = value
+	case []byte:
+		data = string(value)
+	default:
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		data = string(encoded)
+	}
+	for _, line := range strings.Split(data, "\n") {
+		builder.WriteString("data: " + line + "\n")
+	}
+	builder.WriteString("\n")
+
+	_, err := io.WriteString(w, builder.String())
+
+	return err
+}
+
+func flush(w http.ResponseWriter) {
+	if flusher, ok := w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
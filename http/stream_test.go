@@ -0,0 +1,96 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/testing/mock"
+)
+
+func TestStream(t *testing.T) {
+	w := httptest.NewRecorder()
+
+	i := 0
+	NewNetHttpContext(w, httptest.NewRequest(http.MethodGet, "/", nil), nil, nil).Response().Stream(func(w io.Writer) bool {
+		i++
+		_, _ = fmt.Fprintf(w, "chunk %d\n", i)
+
+		return i < 3
+	})
+
+	assert.Equal(t, "chunk 1\nchunk 2\nchunk 3\n", w.Body.String())
+	assert.True(t, w.Flushed)
+}
+
+func TestStreamStopsWhenClientDisconnects(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/", nil).WithContext(ctx)
+
+	i := 0
+	NewNetHttpContext(w, req, nil, nil).Response().Stream(func(w io.Writer) bool {
+		i++
+		if i == 2 {
+			cancel()
+		}
+
+		return true
+	})
+
+	assert.Equal(t, 2, i)
+}
+
+func TestSSE(t *testing.T) {
+	mockConfig := mock.Config()
+	mockConfig.On("GetInt", "http.sse_keep_alive", 15).Return(1)
+
+	events := make(chan contractshttp.Event)
+	go func() {
+		events <- contractshttp.Event{ID: "1", Event: "progress", Data: map[string]int{"percent": 50}}
+		events <- contractshttp.Event{Data: "line 1\nline 2", Retry: 3000}
+		time.Sleep(1100 * time.Millisecond)
+		close(events)
+	}()
+
+	w := httptest.NewRecorder()
+	NewNetHttpContext(w, httptest.NewRequest(http.MethodGet, "/", nil), nil, nil).Response().SSE(events)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/event-stream", w.Header().Get("Content-Type"))
+	assert.Equal(t, "no-cache", w.Header().Get("Cache-Control"))
+	assert.Equal(t, "id: 1\nevent: progress\ndata: {\"percent\":50}\n\n"+
+		"retry: 3000\ndata: line 1\ndata: line 2\n\n"+
+		": keep-alive\n\n", w.Body.String())
+
+	mockConfig.AssertExpectations(t)
+}
+
+func TestSSEStopsWhenClientDisconnects(t *testing.T) {
+	mockConfig := mock.Config()
+	mockConfig.On("GetInt", "http.sse_keep_alive", 15).Return(15)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	httpCtx := NewNetHttpContext(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil).WithContext(ctx), nil, nil)
+
+	done := make(chan struct{})
+	go func() {
+		httpCtx.Response().SSE(make(chan contractshttp.Event))
+		close(done)
+	}()
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("SSE doesn't stop when the client disconnects")
+	}
+	assert.NotNil(t, httpCtx.Err())
+}
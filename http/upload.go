@@ -0,0 +1,54 @@
+package http
+
+import (
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+
+	contractsfilesystem "github.com/goravel/framework/contracts/filesystem"
+	"github.com/goravel/framework/filesystem"
+)
+
+// FilesFromHeaders Copy the uploaded files to temp files, the same as Request.File.
+func FilesFromHeaders(headers []*multipart.FileHeader) ([]contractsfilesystem.File, error) {
+	if len(headers) == 0 {
+		return nil, http.ErrMissingFile
+	}
+
+	files := make([]contractsfilesystem.File, 0, len(headers))
+	for _, header := range headers {
+		file, err := filesystem.NewFileFromRequest(header)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+
+	return files, nil
+}
+
+// StreamFiles Read the file parts of a multipart request, the other parts are skipped.
+func StreamFiles(request *http.Request, handler func(name string, file contractsfilesystem.StreamedFile) error) error {
+	reader, err := request.MultipartReader()
+	if err != nil {
+		return err
+	}
+
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if part.FileName() != "" {
+			if err := handler(part.FormName(), filesystem.NewStreamedFile(part.FileName(), part)); err != nil {
+				return err
+			}
+		}
+		_ = part.Close()
+	}
+}
@@ -0,0 +1,93 @@
+package http
+
+import (
+	"bytes"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	contractsfilesystem "github.com/goravel/framework/contracts/filesystem"
+	contractshttp "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/filesystem"
+	testingmock "github.com/goravel/framework/testing/mock"
+)
+
+var pngHead = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+func newUploadRequest() *http.Request {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	_ = writer.WriteField("title", "holiday")
+	part, _ := writer.CreateFormFile("photos", "beach.png")
+	_, _ = part.Write(pngHead)
+	part, _ = writer.CreateFormFile("photos", "notes.txt")
+	_, _ = part.Write([]byte("goravel"))
+	_ = writer.Close()
+
+	request := httptest.NewRequest(http.MethodPost, "/upload", body)
+	request.Header.Set("Content-Type", writer.FormDataContentType())
+
+	return request
+}
+
+func uploadRequest() contractshttp.Request {
+	return NewNetHttpContext(httptest.NewRecorder(), newUploadRequest(), nil, nil).Request()
+}
+
+func TestRequestFiles(t *testing.T) {
+	mockConfig := testingmock.Config()
+	mockConfig.On("GetString", "filesystems.default").Return("local")
+
+	files, err := uploadRequest().Files("photos")
+	assert.Nil(t, err)
+	assert.Len(t, files, 2)
+	assert.Equal(t, "beach.png", files[0].GetClientOriginalName())
+	assert.Equal(t, "notes.txt", files[1].GetClientOriginalName())
+
+	mimeType, err := files[0].MimeType()
+	assert.Nil(t, err)
+	assert.Equal(t, "image/png", mimeType)
+
+	_, err = files[1].AllowedMimeTypes("image/*").Store("photos")
+	assert.ErrorIs(t, err, filesystem.ErrorMimeTypeNotAllowed)
+
+	_, err = uploadRequest().Files("missing")
+	assert.ErrorIs(t, err, http.ErrMissingFile)
+}
+
+func TestRequestStreamFiles(t *testing.T) {
+	mockConfig := testingmock.Config()
+	mockConfig.On("GetString", "filesystems.default").Return("local")
+
+	mockStorage, mockDriver, _ := testingmock.Storage()
+	mockStorage.On("Disk", "local").Return(mockDriver)
+	mockDriver.On("PutStream", "photos/beach.png", mock.Anything).Run(func(args mock.Arguments) {
+		content, err := io.ReadAll(args.Get(1).(io.Reader))
+		assert.Nil(t, err)
+		assert.Equal(t, pngHead, content)
+	}).Return(nil).Once()
+
+	var stored []string
+	err := uploadRequest().StreamFiles(func(input string, file contractsfilesystem.StreamedFile) error {
+		assert.Equal(t, "photos", input)
+
+		path, err := file.AllowedMimeTypes("image/*").StoreAs("photos", "beach")
+		if err != nil {
+			assert.ErrorIs(t, err, filesystem.ErrorMimeTypeNotAllowed)
+			assert.Equal(t, "notes.txt", file.GetClientOriginalName())
+
+			return nil
+		}
+		stored = append(stored, path)
+
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"photos/beach.png"}, stored)
+	mockDriver.AssertExpectations(t)
+}
@@ -0,0 +1,103 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	contractsvalidation "github.com/goravel/framework/contracts/validation"
+	"github.com/goravel/framework/facades"
+	"github.com/goravel/framework/validation"
+)
+
+const defaultMultipartMemory = 32 << 20
+
+var ErrorUnauthorized = errors.New("this action is unauthorized")
+
+// Validate Validate the input of a request, the params are the parameters of the route.
+func Validate(request *http.Request, params map[string]string, rules map[string]string, options ...contractsvalidation.Option) (contractsvalidation.Validator, error) {
+	if len(rules) == 0 {
+		return nil, errors.New("rules can't be empty")
+	}
+
+	data, err := inputData(request, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return facades.Validation.Make(data, rules, options...)
+}
+
+// ValidateRequest Authorize and validate a request with a FormRequest, it's bound to the FormRequest if it passes.
+func ValidateRequest(request contractshttp.Request, formRequest contractshttp.FormRequest) (contractsvalidation.Errors, error) {
+	if !formRequest.Authorize() {
+		return nil, ErrorUnauthorized
+	}
+
+	validator, err := request.Validate(formRequest.Rules(), validation.Messages(formRequest.Messages()), validation.Attributes(formRequest.Attributes()))
+	if err != nil {
+		return nil, err
+	}
+
+	if validator.Fails() {
+		return validator.Errors(), nil
+	}
+
+	return nil, validator.Bind(formRequest)
+}
+
+// inputData Merge the query, form, json and route input of a request, the latter overrides the former.
+func inputData(request *http.Request, params map[string]string) (map[string]interface{}, error) {
+	data := make(map[string]interface{})
+	for key, value := range validation.ValuesToMap(request.URL.Query()) {
+		data[key] = value
+	}
+
+	contentType := request.Header.Get("Content-Type")
+	switch {
+	case strings.Contains(contentType, "application/json"):
+		if request.Body == nil {
+			break
+		}
+
+		body, err := ioutil.ReadAll(request.Body)
+		if err != nil {
+			return nil, err
+		}
+		request.Body = ioutil.NopCloser(bytes.NewBuffer(body))
+
+		if len(bytes.TrimSpace(body)) > 0 {
+			var jsonData map[string]interface{}
+			if err := json.Unmarshal(body, &jsonData); err != nil {
+				return nil, err
+			}
+			for key, value := range jsonData {
+				data[key] = value
+			}
+		}
+	case strings.Contains(contentType, "multipart/form-data"):
+		if err := request.ParseMultipartForm(defaultMultipartMemory); err != nil {
+			return nil, err
+		}
+		for key, value := range validation.ValuesToMap(request.PostForm) {
+			data[key] = value
+		}
+	case strings.Contains(contentType, "application/x-www-form-urlencoded"):
+		if err := request.ParseForm(); err != nil {
+			return nil, err
+		}
+		for key, value := range validation.ValuesToMap(request.PostForm) {
+			data[key] = value
+		}
+	}
+
+	for key, value := range params {
+		data[key] = value
+	}
+
+	return data, nil
+}
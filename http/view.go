@@ -0,0 +1,23 @@
+package http
+
+import (
+	"bytes"
+	"net/http"
+
+	"github.com/goravel/framework/facades"
+)
+
+// WriteView Render the view to a buffer first, so a half rendered page isn't sent if the rendering fails.
+func WriteView(w http.ResponseWriter, view string, data interface{}) {
+	var buffer bytes.Buffer
+	if err := facades.View.Render(&buffer, view, data); err != nil {
+		facades.Log.Error(err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(buffer.Bytes())
+}
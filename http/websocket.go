@@ -0,0 +1,162 @@
+package http
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/facades"
+)
+
+// WebSocketConnection The connection sends a ping every http.websocket.ping_interval seconds, the connection
+// is closed if the pong isn't received in http.websocket.pong_timeout seconds when the handler is reading.
+type WebSocketConnection struct {
+	conn      *websocket.Conn
+	writeMu   sync.Mutex
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// UpgradeWebSocket Upgrade the request to WebSocket, a http error is written to the response if it fails.
+func UpgradeWebSocket(w http.ResponseWriter, r *http.Request) (*WebSocketConnection, error) {
+	upgrader := websocket.Upgrader{
+		CheckOrigin: checkOrigin,
+	}
+
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	connection := &WebSocketConnection{
+		conn: conn,
+		done: make(chan struct{}),
+	}
+	connection.heartbeat(
+		time.Duration(facades.Config.GetInt("http.websocket.ping_interval", 30))*time.Second,
+		time.Duration(facades.Config.GetInt("http.websocket.pong_timeout", 60))*time.Second,
+	)
+
+	return connection, nil
+}
+
+func (c *WebSocketConnection) ReadJson(obj interface{}) error {
+	_, data, err := c.ReadMessage()
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, obj)
+}
+
+func (c *WebSocketConnection) WriteJson(obj interface{}) error {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+
+	return c.WriteMessage(contractshttp.WebSocketTextMessage, data)
+}
+
+func (c *WebSocketConnection) ReadMessage() (int, []byte, error) {
+	messageType, data, err := c.conn.ReadMessage()
+	if err != nil {
+		c.closeDone()
+		if websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway, websocket.CloseNoStatusReceived) {
+			return messageType, nil, io.EOF
+		}
+
+		return messageType, nil, err
+	}
+
+	return messageType, data, nil
+}
+
+func (c *WebSocketConnection) WriteMessage(messageType int, data []byte) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	return c.conn.WriteMessage(messageType, data)
+}
+
+func (c *WebSocketConnection) Close(code int, reason string) error {
+	select {
+	case <-c.done:
+		return c.conn.Close()
+	default:
+	}
+
+	c.writeMu.Lock()
+	_ = c.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason), time.Now().Add(time.Second))
+	c.writeMu.Unlock()
+	c.closeDone()
+
+	return c.conn.Close()
+}
+
+func (c *WebSocketConnection) Done() <-chan struct{} {
+	return c.done
+}
+
+func (c *WebSocketConnection) heartbeat(pingInterval, pongTimeout time.Duration) {
+	_ = c.conn.SetReadDeadline(time.Now().Add(pongTimeout))
+	c.conn.SetPongHandler(func(string) error {
+		return c.conn.SetReadDeadline(time.Now().Add(pongTimeout))
+	})
+
+	go func() {
+		ticker := time.NewTicker(pingInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-c.done:
+				return
+			case <-ticker.C:
+				c.writeMu.Lock()
+				err := c.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(pingInterval))
+				c.writeMu.Unlock()
+				if err != nil {
+					c.closeDone()
+
+					return
+				}
+			}
+		}
+	}()
+}
+
+func (c *WebSocketConnection) closeDone() {
+	c.closeOnce.Do(func() {
+		close(c.done)
+	})
+}
+
+// checkOrigin Allow the origins in http.websocket.allowed_origins, or the same origin if it's empty.
+func checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+
+	allowedOrigins, _ := facades.Config.Get("http.websocket.allowed_origins", []string{}).([]string)
+	for _, allowedOrigin := range allowedOrigins {
+		if allowedOrigin == "*" || strings.EqualFold(allowedOrigin, origin) {
+			return true
+		}
+	}
+
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+
+	return strings.EqualFold(u.Host, r.Host)
+}
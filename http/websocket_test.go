@@ -0,0 +1,32 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/testing/mock"
+)
+
+func TestCheckOrigin(t *testing.T) {
+	mockConfig := mock.Config()
+	mockConfig.On("Get", "http.websocket.allowed_origins", []string{}).Return([]string{"https://goravel.dev"})
+
+	tests := []struct {
+		origin string
+		expect bool
+	}{
+		{origin: "", expect: true},
+		{origin: "http://example.com", expect: true},
+		{origin: "https://goravel.dev", expect: true},
+		{origin: "https://evil.com", expect: false},
+	}
+
+	for _, test := range tests {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/ws", nil)
+		req.Header.Set("Origin", test.origin)
+		assert.Equal(t, test.expect, checkOrigin(req), test.origin)
+	}
+}
@@ -0,0 +1,102 @@
+package httpclient
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/goravel/framework/contracts/httpclient"
+)
+
+type fakeRoute struct {
+	pattern  string
+	regex    *regexp.Regexp
+	response httpclient.FakeResponse
+}
+
+// fake Respond the requests by the patterns of the urls and record them.
+type fake struct {
+	mu       sync.RWMutex
+	routes   []fakeRoute
+	requests []httpclient.RecordedRequest
+}
+
+func newFake(responses map[string]httpclient.FakeResponse) *fake {
+	routes := make([]fakeRoute, 0, len(responses))
+	for pattern, response := range responses {
+		// The pattern matches the end of the url, so the scheme can be omitted: github.com/*
+		regex := "^.*" + strings.ReplaceAll(regexp.QuoteMeta(pattern), `\*`, ".*") + "$"
+		routes = append(routes, fakeRoute{pattern: pattern, regex: regexp.MustCompile(regex), response: response})
+	}
+
+	// The longer patterns are more specific, so they are matched first.
+	sort.SliceStable(routes, func(i, j int) bool {
+		if len(routes[i].pattern) != len(routes[j].pattern) {
+			return len(routes[i].pattern) > len(routes[j].pattern)
+		}
+
+		return routes[i].pattern < routes[j].pattern
+	})
+
+	return &fake{routes: routes}
+}
+
+// respond Get the fake response of the request and record it.
+func (r *fake) respond(request *http.Request, body []byte) *Response {
+	response := &Response{status: http.StatusOK, headers: http.Header{}}
+	for _, route := range r.routes {
+		if !route.regex.MatchString(request.URL.String()) {
+			continue
+		}
+
+		response = newFakeResponse(route.response)
+		break
+	}
+
+	r.mu.Lock()
+	r.requests = append(r.requests, httpclient.RecordedRequest{
+		Method:   request.Method,
+		Url:      request.URL.String(),
+		Headers:  request.Header.Clone(),
+		Body:     body,
+		Response: response,
+	})
+	r.mu.Unlock()
+
+	return response
+}
+
+func (r *fake) recorded() []httpclient.RecordedRequest {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return append([]httpclient.RecordedRequest(nil), r.requests...)
+}
+
+func newFakeResponse(fakeResponse httpclient.FakeResponse) *Response {
+	response := &Response{status: fakeResponse.Status, headers: http.Header{}}
+	if response.status == 0 {
+		response.status = http.StatusOK
+	}
+	for key, value := range fakeResponse.Headers {
+		response.headers.Set(key, value)
+	}
+
+	switch body := fakeResponse.Body.(type) {
+	case nil:
+	case string:
+		response.body = []byte(body)
+	case []byte:
+		response.body = body
+	default:
+		response.body, _ = json.Marshal(body)
+		if response.headers.Get("Content-Type") == "" {
+			response.headers.Set("Content-Type", "application/json")
+		}
+	}
+
+	return response
+}
@@ -0,0 +1,155 @@
+package httpclient
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/goravel/framework/contracts/httpclient"
+	"github.com/goravel/framework/facades"
+)
+
+type Http struct {
+	timeout time.Duration
+
+	mu   sync.RWMutex
+	fake *fake
+}
+
+func NewHttp() *Http {
+	return &Http{
+		timeout: time.Duration(facades.Config.GetInt("http.client.timeout", 30)) * time.Second,
+	}
+}
+
+func (r *Http) Fake(responses map[string]httpclient.FakeResponse) httpclient.Http {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.fake = newFake(responses)
+
+	return r
+}
+
+func (r *Http) Unfake() httpclient.Http {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.fake = nil
+
+	return r
+}
+
+func (r *Http) Recorded() []httpclient.RecordedRequest {
+	fake := r.getFake()
+	if fake == nil {
+		return nil
+	}
+
+	return fake.recorded()
+}
+
+func (r *Http) AssertSent(callback func(request httpclient.RecordedRequest) bool) bool {
+	for _, request := range r.Recorded() {
+		if callback(request) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *Http) AssertNotSent(callback func(request httpclient.RecordedRequest) bool) bool {
+	return !r.AssertSent(callback)
+}
+
+func (r *Http) AssertSentCount(count int) bool {
+	return len(r.Recorded()) == count
+}
+
+func (r *Http) AssertNothingSent() bool {
+	return len(r.Recorded()) == 0
+}
+
+func (r *Http) WithContext(ctx context.Context) httpclient.Request {
+	return NewRequest(r).WithContext(ctx)
+}
+
+func (r *Http) BaseUrl(url string) httpclient.Request {
+	return NewRequest(r).BaseUrl(url)
+}
+
+func (r *Http) WithHeader(key, value string) httpclient.Request {
+	return NewRequest(r).WithHeader(key, value)
+}
+
+func (r *Http) WithHeaders(headers map[string]string) httpclient.Request {
+	return NewRequest(r).WithHeaders(headers)
+}
+
+func (r *Http) WithToken(token string, tokenType ...string) httpclient.Request {
+	return NewRequest(r).WithToken(token, tokenType...)
+}
+
+func (r *Http) WithBasicAuth(username, password string) httpclient.Request {
+	return NewRequest(r).WithBasicAuth(username, password)
+}
+
+func (r *Http) WithQuery(key, value string) httpclient.Request {
+	return NewRequest(r).WithQuery(key, value)
+}
+
+func (r *Http) AsForm() httpclient.Request {
+	return NewRequest(r).AsForm()
+}
+
+func (r *Http) Accept(contentType string) httpclient.Request {
+	return NewRequest(r).Accept(contentType)
+}
+
+func (r *Http) AcceptJson() httpclient.Request {
+	return NewRequest(r).AcceptJson()
+}
+
+func (r *Http) Timeout(timeout time.Duration) httpclient.Request {
+	return NewRequest(r).Timeout(timeout)
+}
+
+func (r *Http) Retry(times int, sleep time.Duration, when ...func(response httpclient.Response, err error) bool) httpclient.Request {
+	return NewRequest(r).Retry(times, sleep, when...)
+}
+
+func (r *Http) Get(url string) (httpclient.Response, error) {
+	return NewRequest(r).Get(url)
+}
+
+func (r *Http) Head(url string) (httpclient.Response, error) {
+	return NewRequest(r).Head(url)
+}
+
+func (r *Http) Post(url string, body interface{}) (httpclient.Response, error) {
+	return NewRequest(r).Post(url, body)
+}
+
+func (r *Http) Put(url string, body interface{}) (httpclient.Response, error) {
+	return NewRequest(r).Put(url, body)
+}
+
+func (r *Http) Patch(url string, body interface{}) (httpclient.Response, error) {
+	return NewRequest(r).Patch(url, body)
+}
+
+func (r *Http) Delete(url string, body ...interface{}) (httpclient.Response, error) {
+	return NewRequest(r).Delete(url, body...)
+}
+
+func (r *Http) Send(method, url string, body interface{}) (httpclient.Response, error) {
+	return NewRequest(r).Send(method, url, body)
+}
+
+func (r *Http) getFake() *fake {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.fake
+}
@@ -0,0 +1,179 @@
+package httpclient
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/mocktracer"
+	"github.com/stretchr/testify/assert"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/contracts/httpclient"
+	"github.com/goravel/framework/testing/mock"
+)
+
+func newHttp() *Http {
+	mockConfig := mock.Config()
+	mockConfig.On("GetInt", "http.client.timeout", 30).Return(30).Once()
+
+	return NewHttp()
+}
+
+func TestSend(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"method":"` + r.Method + `","uri":"` + r.URL.RequestURI() + `","authorization":"` + r.Header.Get("Authorization") +
+			`","content_type":"` + r.Header.Get("Content-Type") + `","body":` + strconv.Quote(string(body)) + `}`))
+	}))
+	defer server.Close()
+
+	client := newHttp()
+
+	response, err := client.BaseUrl(server.URL+"/api/").WithToken("token").WithQuery("page", "2").
+		Post("/users", map[string]string{"name": "Goravel"})
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusCreated, response.Status())
+	assert.True(t, response.Successful())
+	var body map[string]string
+	assert.Nil(t, response.Json(&body))
+	assert.Equal(t, map[string]string{
+		"method": "POST", "uri": "/api/users?page=2", "authorization": "Bearer token",
+		"content_type": "application/json", "body": `{"name":"Goravel"}`,
+	}, body)
+
+	response, err = client.AsForm().WithBasicAuth("user", "secret").Put(server.URL+"/users/1", map[string]interface{}{"age": 18})
+	assert.Nil(t, err)
+	assert.Nil(t, response.Json(&body))
+	assert.Equal(t, "Basic dXNlcjpzZWNyZXQ=", body["authorization"])
+	assert.Equal(t, "application/x-www-form-urlencoded", body["content_type"])
+	assert.Equal(t, "age=18", body["body"])
+
+	_, err = client.AsForm().Post(server.URL, struct{}{})
+	assert.ErrorIs(t, err, ErrorUnsupportedFormBody)
+}
+
+func TestRetry(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	client := newHttp()
+
+	response, err := client.Retry(2, time.Millisecond).Get(server.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, "ok", response.String())
+	assert.Equal(t, int32(3), attempts)
+
+	atomic.StoreInt32(&attempts, 0)
+	response, err = client.Retry(1, time.Millisecond).Get(server.URL)
+	assert.Nil(t, err)
+	assert.True(t, response.ServerError())
+	assert.Equal(t, int32(2), attempts)
+
+	atomic.StoreInt32(&attempts, 0)
+	response, err = client.Retry(3, time.Millisecond, func(response httpclient.Response, err error) bool {
+		return false
+	}).Get(server.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, response.Status())
+	assert.Equal(t, int32(1), attempts)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = client.WithContext(ctx).Retry(3, time.Second).Get(server.URL)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestTracing(t *testing.T) {
+	var traceHeader string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceHeader = r.Header.Get("Mockpfx-Ids-Traceid")
+	}))
+	defer server.Close()
+
+	tracer := mocktracer.New()
+	parentSpan := tracer.StartSpan("/orders")
+	ctx := context.WithValue(context.Background(), contractshttp.OpentracingTracer, tracer)
+	ctx = context.WithValue(ctx, contractshttp.OpentracingCtx, opentracing.ContextWithSpan(context.Background(), parentSpan))
+
+	_, err := newHttp().WithContext(ctx).Get(server.URL)
+	assert.Nil(t, err)
+	parentSpan.Finish()
+
+	spans := tracer.FinishedSpans()
+	assert.Len(t, spans, 2)
+	assert.Equal(t, "call HTTP", spans[0].OperationName)
+	assert.Equal(t, parentSpan.Context().(mocktracer.MockSpanContext).SpanID, spans[0].ParentID)
+	assert.Equal(t, uint16(http.StatusOK), spans[0].Tag("http.status_code"))
+	assert.NotEmpty(t, traceHeader)
+}
+
+func TestFake(t *testing.T) {
+	client := newHttp()
+	client.Fake(map[string]httpclient.FakeResponse{
+		"github.com/users/*": {Status: http.StatusOK, Body: map[string]string{"name": "Goravel"}},
+		"github.com/*":       {Status: http.StatusNotFound, Body: "not found", Headers: map[string]string{"X-Fake": "1"}},
+	})
+	assert.True(t, client.AssertNothingSent())
+
+	response, err := client.WithHeader("X-Id", "1").Get("https://github.com/users/1")
+	assert.Nil(t, err)
+	assert.Equal(t, `{"name":"Goravel"}`, response.String())
+	assert.Equal(t, "application/json", response.Header("Content-Type"))
+
+	response, err = client.Post("https://github.com/repos", map[string]string{"name": "framework"})
+	assert.Nil(t, err)
+	assert.True(t, response.ClientError())
+	assert.Equal(t, "1", response.Header("X-Fake"))
+
+	response, err = client.Delete("https://gitlab.com/users/1")
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, response.Status())
+	assert.Equal(t, "", response.String())
+
+	assert.True(t, client.AssertSentCount(3))
+	assert.True(t, client.AssertSent(func(request httpclient.RecordedRequest) bool {
+		return request.Method == http.MethodGet && request.Url == "https://github.com/users/1" && request.Headers.Get("X-Id") == "1"
+	}))
+	assert.True(t, client.AssertSent(func(request httpclient.RecordedRequest) bool {
+		return string(request.Body) == `{"name":"framework"}` && request.Response.Status() == http.StatusNotFound
+	}))
+	assert.True(t, client.AssertNotSent(func(request httpclient.RecordedRequest) bool {
+		return request.Method == http.MethodPut
+	}))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("real"))
+	}))
+	defer server.Close()
+
+	// The requests may be sent by other goroutines while the fake is replaced.
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = client.Get(server.URL)
+	}()
+	client.Unfake()
+	<-done
+
+	response, err = client.Get(server.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, "real", response.String())
+	assert.True(t, client.AssertNothingSent())
+}
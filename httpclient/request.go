@@ -0,0 +1,346 @@
+package httpclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/contracts/httpclient"
+)
+
+var ErrorUnsupportedFormBody = errors.New("the form body must be url.Values, map[string]string or map[string]interface{}")
+
+type Request struct {
+	http      *Http
+	ctx       context.Context
+	baseUrl   string
+	headers   http.Header
+	query     url.Values
+	asForm    bool
+	timeout   time.Duration
+	retries   int
+	sleep     time.Duration
+	retryWhen func(response httpclient.Response, err error) bool
+}
+
+func NewRequest(factory *Http) *Request {
+	return &Request{
+		http:    factory,
+		ctx:     context.Background(),
+		headers: make(map[string][]string),
+		query:   make(url.Values),
+		timeout: factory.timeout,
+	}
+}
+
+func (r *Request) WithContext(ctx context.Context) httpclient.Request {
+	r.ctx = ctx
+
+	return r
+}
+
+func (r *Request) BaseUrl(url string) httpclient.Request {
+	r.baseUrl = url
+
+	return r
+}
+
+func (r *Request) WithHeader(key, value string) httpclient.Request {
+	r.headers.Set(key, value)
+
+	return r
+}
+
+func (r *Request) WithHeaders(headers map[string]string) httpclient.Request {
+	for key, value := range headers {
+		r.headers.Set(key, value)
+	}
+
+	return r
+}
+
+func (r *Request) WithToken(token string, tokenType ...string) httpclient.Request {
+	authorizationType := "Bearer"
+	if len(tokenType) > 0 {
+		authorizationType = tokenType[0]
+	}
+
+	return r.WithHeader("Authorization", authorizationType+" "+token)
+}
+
+func (r *Request) WithBasicAuth(username, password string) httpclient.Request {
+	return r.WithToken(base64.StdEncoding.EncodeToString([]byte(username+":"+password)), "Basic")
+}
+
+func (r *Request) WithQuery(key, value string) httpclient.Request {
+	r.query.Add(key, value)
+
+	return r
+}
+
+func (r *Request) AsForm() httpclient.Request {
+	r.asForm = true
+
+	return r
+}
+
+func (r *Request) Accept(contentType string) httpclient.Request {
+	return r.WithHeader("Accept", contentType)
+}
+
+func (r *Request) AcceptJson() httpclient.Request {
+	return r.Accept("application/json")
+}
+
+func (r *Request) Timeout(timeout time.Duration) httpclient.Request {
+	r.timeout = timeout
+
+	return r
+}
+
+func (r *Request) Retry(times int, sleep time.Duration, when ...func(response httpclient.Response, err error) bool) httpclient.Request {
+	r.retries = times
+	r.sleep = sleep
+	if len(when) > 0 {
+		r.retryWhen = when[0]
+	}
+
+	return r
+}
+
+func (r *Request) Get(url string) (httpclient.Response, error) {
+	return r.Send(http.MethodGet, url, nil)
+}
+
+func (r *Request) Head(url string) (httpclient.Response, error) {
+	return r.Send(http.MethodHead, url, nil)
+}
+
+func (r *Request) Post(url string, body interface{}) (httpclient.Response, error) {
+	return r.Send(http.MethodPost, url, body)
+}
+
+func (r *Request) Put(url string, body interface{}) (httpclient.Response, error) {
+	return r.Send(http.MethodPut, url, body)
+}
+
+func (r *Request) Patch(url string, body interface{}) (httpclient.Response, error) {
+	return r.Send(http.MethodPatch, url, body)
+}
+
+func (r *Request) Delete(url string, body ...interface{}) (httpclient.Response, error) {
+	if len(body) > 0 {
+		return r.Send(http.MethodDelete, url, body[0])
+	}
+
+	return r.Send(http.MethodDelete, url, nil)
+}
+
+func (r *Request) Send(method, url string, body interface{}) (httpclient.Response, error) {
+	fullUrl, err := r.url(url)
+	if err != nil {
+		return nil, err
+	}
+	payload, contentType, err := r.encode(body)
+	if err != nil {
+		return nil, err
+	}
+
+	sleep := r.sleep
+	for attempt := 0; ; attempt++ {
+		response, err := r.send(method, fullUrl, payload, contentType)
+		if attempt >= r.retries || !r.shouldRetry(response, err) {
+			return toResponse(response), err
+		}
+
+		select {
+		case <-time.After(sleep):
+		case <-r.ctx.Done():
+			return toResponse(response), r.ctx.Err()
+		}
+		sleep *= 2
+	}
+}
+
+func (r *Request) send(method, url string, payload []byte, contentType string) (*Response, error) {
+	var body io.Reader
+	if payload != nil {
+		body = bytes.NewReader(payload)
+	}
+
+	request, err := http.NewRequestWithContext(r.ctx, method, url, body)
+	if err != nil {
+		return nil, err
+	}
+	request.Header = r.headers.Clone()
+	if contentType != "" && request.Header.Get("Content-Type") == "" {
+		request.Header.Set("Content-Type", contentType)
+	}
+
+	span := r.startSpan(request)
+	if span != nil {
+		defer span.Finish()
+	}
+
+	var response *Response
+	if fake := r.http.getFake(); fake != nil {
+		response = fake.respond(request, payload)
+	} else {
+		response, err = r.do(request)
+		if err != nil {
+			if span != nil {
+				ext.Error.Set(span, true)
+			}
+
+			return nil, err
+		}
+	}
+	if span != nil {
+		ext.HTTPStatusCode.Set(span, uint16(response.status))
+	}
+
+	return response, nil
+}
+
+func (r *Request) do(request *http.Request) (*Response, error) {
+	client := &http.Client{Timeout: r.timeout}
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Response{status: response.StatusCode, headers: response.Header, body: body}, nil
+}
+
+// startSpan Start a client span if the context has the span of the Opentracing middleware, the span is injected into
+// the headers of the request, so the trace is continued by the service.
+func (r *Request) startSpan(request *http.Request) opentracing.Span {
+	parentCtx, ok := r.ctx.Value(contractshttp.OpentracingCtx).(context.Context)
+	if !ok {
+		parentCtx = r.ctx
+	}
+	parentSpan := opentracing.SpanFromContext(parentCtx)
+	if parentSpan == nil {
+		return nil
+	}
+	tracer, ok := r.ctx.Value(contractshttp.OpentracingTracer).(opentracing.Tracer)
+	if !ok {
+		tracer = parentSpan.Tracer()
+	}
+
+	span := tracer.StartSpan(
+		"call HTTP",
+		opentracing.ChildOf(parentSpan.Context()),
+		opentracing.Tag{Key: string(ext.Component), Value: "HTTP"},
+		ext.SpanKindRPCClient,
+	)
+	ext.HTTPMethod.Set(span, request.Method)
+	ext.HTTPUrl.Set(span, request.URL.String())
+	if err := tracer.Inject(span.Context(), opentracing.HTTPHeaders, opentracing.HTTPHeadersCarrier(request.Header)); err != nil {
+		ext.Error.Set(span, true)
+	}
+
+	return span
+}
+
+func (r *Request) shouldRetry(response *Response, err error) bool {
+	if r.ctx.Err() != nil {
+		return false
+	}
+	if r.retryWhen != nil {
+		return r.retryWhen(toResponse(response), err)
+	}
+
+	return err != nil || response.ServerError()
+}
+
+// url Join the url with the base url unless it's absolute, and add the queries.
+func (r *Request) url(rawUrl string) (string, error) {
+	if r.baseUrl != "" && !strings.Contains(rawUrl, "://") {
+		rawUrl = strings.TrimRight(r.baseUrl, "/") + "/" + strings.TrimLeft(rawUrl, "/")
+	}
+
+	u, err := url.Parse(rawUrl)
+	if err != nil {
+		return "", err
+	}
+	if len(r.query) > 0 {
+		query := u.Query()
+		for key, values := range r.query {
+			for _, value := range values {
+				query.Add(key, value)
+			}
+		}
+		u.RawQuery = query.Encode()
+	}
+
+	return u.String(), nil
+}
+
+// encode Get the payload and the Content-Type of the body, the body is buffered, so it can be sent again on retries.
+func (r *Request) encode(body interface{}) ([]byte, string, error) {
+	switch body := body.(type) {
+	case nil:
+		return nil, "", nil
+	case string:
+		return []byte(body), "", nil
+	case []byte:
+		return body, "", nil
+	case io.Reader:
+		payload, err := ioutil.ReadAll(body)
+
+		return payload, "", err
+	case url.Values:
+		return []byte(body.Encode()), "application/x-www-form-urlencoded", nil
+	}
+
+	if r.asForm {
+		form := make(url.Values)
+		switch body := body.(type) {
+		case map[string]string:
+			for key, value := range body {
+				form.Set(key, value)
+			}
+		case map[string]interface{}:
+			for key, value := range body {
+				form.Set(key, fmt.Sprint(value))
+			}
+		default:
+			return nil, "", ErrorUnsupportedFormBody
+		}
+
+		return []byte(form.Encode()), "application/x-www-form-urlencoded", nil
+	}
+
+	payload, err := json.Marshal(body)
+
+	return payload, "application/json", err
+}
+
+// toResponse Convert the response to the contract, a nil response is converted to a nil interface.
+func toResponse(response *Response) httpclient.Response {
+	if response == nil {
+		return nil
+	}
+
+	return response
+}
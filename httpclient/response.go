@@ -0,0 +1,52 @@
+package httpclient
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+type Response struct {
+	status  int
+	headers http.Header
+	body    []byte
+}
+
+func (r *Response) Status() int {
+	return r.status
+}
+
+func (r *Response) Header(key string) string {
+	return r.headers.Get(key)
+}
+
+func (r *Response) Headers() http.Header {
+	return r.headers
+}
+
+func (r *Response) Body() []byte {
+	return r.body
+}
+
+func (r *Response) String() string {
+	return string(r.body)
+}
+
+func (r *Response) Json(obj interface{}) error {
+	return json.Unmarshal(r.body, obj)
+}
+
+func (r *Response) Successful() bool {
+	return r.status >= http.StatusOK && r.status < http.StatusMultipleChoices
+}
+
+func (r *Response) Failed() bool {
+	return r.ClientError() || r.ServerError()
+}
+
+func (r *Response) ClientError() bool {
+	return r.status >= http.StatusBadRequest && r.status < http.StatusInternalServerError
+}
+
+func (r *Response) ServerError() bool {
+	return r.status >= http.StatusInternalServerError
+}
@@ -0,0 +1,16 @@
+package httpclient
+
+import (
+	"github.com/goravel/framework/facades"
+)
+
+type ServiceProvider struct {
+}
+
+func (http *ServiceProvider) Register() {
+	facades.Http = NewHttp()
+}
+
+func (http *ServiceProvider) Boot() {
+
+}
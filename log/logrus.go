@@ -3,6 +3,7 @@ package log
 import (
 	"context"
 	"errors"
+	contractshttp "github.com/goravel/framework/contracts/http"
 	"github.com/goravel/framework/contracts/log"
 	"github.com/goravel/framework/facades"
 	"github.com/goravel/framework/log/logger"
@@ -44,7 +45,13 @@ func logrusInstance() *logrus.Logger {
 func (r *Logrus) WithContext(ctx context.Context) log.Writer {
 	switch r.Writer.(type) {
 	case *Writer:
-		return NewWriter(r.instance.WithContext(ctx))
+		entry := r.instance.WithContext(ctx)
+		// The request id is added to the entries, so they can be correlated with the access log of the request.
+		if requestId, ok := ctx.Value(contractshttp.RequestIdKey).(string); ok && requestId != "" {
+			entry = entry.WithField("request_id", requestId)
+		}
+
+		return NewWriter(entry)
 	default:
 		return r.Writer
 	}
@@ -58,6 +65,10 @@ func NewWriter(instance *logrus.Entry) log.Writer {
 	return &Writer{instance: instance}
 }
 
+func (r *Writer) WithFields(fields map[string]interface{}) log.Writer {
+	return NewWriter(r.instance.WithFields(fields))
+}
+
 func (r *Writer) Debug(args ...interface{}) {
 	r.instance.Debug(args...)
 }
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	configmocks "github.com/goravel/framework/contracts/config/mocks"
+	contractshttp "github.com/goravel/framework/contracts/http"
 	"github.com/goravel/framework/facades"
 	"github.com/goravel/framework/support/file"
 	"github.com/goravel/framework/support/time"
@@ -144,6 +145,20 @@ func (s *LogrusTestSuite) TestLogrus() {
 				assert.True(s.T(), file.Contain(dailyLog, "test.info: Goravel"))
 			},
 		},
+		{
+			name: "WithFields",
+			setup: func() {
+				mockDriverConfig(mockConfig)
+
+				initFacadesLog()
+				ctx := context.WithValue(context.Background(), contractshttp.RequestIdKey, "abc")
+				facades.Log.WithContext(ctx).WithFields(map[string]interface{}{"status": 200}).Info("Goravel")
+			},
+			assert: func(name string) {
+				assert.True(s.T(), file.Contain(singleLog, `test.info: Goravel {"request_id":"abc","status":200}`))
+				assert.True(s.T(), file.Contain(dailyLog, `test.info: Goravel {"request_id":"abc","status":200}`))
+			},
+		},
 		{
 			name: "Infof",
 			setup: func() {
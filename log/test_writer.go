@@ -14,6 +14,11 @@ func NewTestWriter() log.Writer {
 	return &TestWriter{}
 }
 
+// WithFields The fields aren't printed by the test writer.
+func (r *TestWriter) WithFields(fields map[string]interface{}) log.Writer {
+	return r
+}
+
 func (r *TestWriter) Debug(args ...interface{}) {
 	fmt.Print(prefix("debug"))
 	fmt.Println(args...)
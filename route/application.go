@@ -1,12 +1,55 @@
 package route
 
 import (
+	"errors"
+	"fmt"
+	"sync"
+
 	"github.com/goravel/framework/contracts/route"
+	"github.com/goravel/framework/facades"
+)
+
+var (
+	driversMu sync.RWMutex
+	// drivers The engines of the http.driver config, the net/http driver is built in, the others register themselves
+	// when their packages are imported, e.g. github.com/goravel/framework/route/gin, so they aren't linked otherwise.
+	drivers = map[string]func() route.Engine{
+		"nethttp": NewNetHttp,
+	}
 )
 
+// RegisterDriver Make an engine available to the http.driver config, it's called in the init function of the drivers.
+func RegisterDriver(name string, driver func() route.Engine) {
+	driversMu.Lock()
+	defer driversMu.Unlock()
+
+	drivers[name] = driver
+}
+
 type Application struct {
 }
 
-func (app *Application) Init() route.Engine {
-	return NewGin()
+// Init Create the engine of the http.driver config. If it isn't set, the gin driver is used when its package is
+// imported, otherwise the net/http driver. An error is returned if the driver isn't registered.
+func (app *Application) Init() (route.Engine, error) {
+	driver := facades.Config.GetString("http.driver")
+
+	driversMu.RLock()
+	if driver == "" {
+		driver = "nethttp"
+		if _, exist := drivers["gin"]; exist {
+			driver = "gin"
+		}
+	}
+	newEngine, exist := drivers[driver]
+	driversMu.RUnlock()
+	if !exist {
+		if driver == "gin" {
+			return nil, errors.New(`http driver gin isn't registered, import _ "github.com/goravel/framework/route/gin" to register it`)
+		}
+
+		return nil, fmt.Errorf("http driver %s isn't supported", driver)
+	}
+
+	return newEngine(), nil
 }
@@ -0,0 +1,68 @@
+package route
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/contracts/route"
+	"github.com/goravel/framework/testing/mock"
+)
+
+type fakeEngine struct {
+	route.Engine
+}
+
+func TestInit(t *testing.T) {
+	// The external tests of the package import the gin driver, so the registered drivers are replaced here.
+	driversMu.Lock()
+	registered := drivers
+	drivers = map[string]func() route.Engine{"nethttp": NewNetHttp}
+	driversMu.Unlock()
+	defer func() {
+		driversMu.Lock()
+		drivers = registered
+		driversMu.Unlock()
+	}()
+
+	tests := []struct {
+		name       string
+		driver     string
+		register   bool
+		expectType route.Engine
+		expectErr  string
+	}{
+		{name: "default without gin", expectType: &NetHttp{}},
+		{name: "default with gin", register: true, expectType: &fakeEngine{}},
+		{name: "nethttp", driver: "nethttp", register: true, expectType: &NetHttp{}},
+		{name: "gin", driver: "gin", register: true, expectType: &fakeEngine{}},
+		{name: "gin isn't imported", driver: "gin", expectErr: `http driver gin isn't registered, import _ "github.com/goravel/framework/route/gin" to register it`},
+		{name: "unknown", driver: "fasthttp", expectErr: "http driver fasthttp isn't supported"},
+	}
+
+	for _, test := range tests {
+		driversMu.Lock()
+		delete(drivers, "gin")
+		driversMu.Unlock()
+		if test.register {
+			RegisterDriver("gin", func() route.Engine {
+				return &fakeEngine{}
+			})
+		}
+
+		mockConfig := mock.Config()
+		mockConfig.On("GetString", "http.driver").Return(test.driver).Once()
+
+		app := Application{}
+		engine, err := app.Init()
+		if test.expectErr == "" {
+			assert.Nil(t, err, test.name)
+			assert.IsType(t, test.expectType, engine, test.name)
+		} else {
+			assert.EqualError(t, err, test.expectErr, test.name)
+			assert.Nil(t, engine, test.name)
+		}
+
+		mockConfig.AssertExpectations(t)
+	}
+}
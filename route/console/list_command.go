@@ -0,0 +1,123 @@
+package console
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/gookit/color"
+
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+	"github.com/goravel/framework/contracts/route"
+	"github.com/goravel/framework/facades"
+)
+
+type ListCommand struct {
+}
+
+// Signature The name and signature of the console command.
+func (receiver *ListCommand) Signature() string {
+	return "route:list"
+}
+
+// Description The console command description.
+func (receiver *ListCommand) Description() string {
+	return "List all registered routes"
+}
+
+// Extend The console command extend.
+func (receiver *ListCommand) Extend() command.Extend {
+	return command.Extend{
+		Category: "route",
+		Flags: []command.Flag{
+			{
+				Name:  "method",
+				Usage: "filter the routes by method",
+			},
+			{
+				Name:  "path",
+				Usage: "only show routes matching the given path pattern",
+			},
+			{
+				Name:   "json",
+				Usage:  "output the route list as JSON",
+				IsBool: true,
+			},
+		},
+	}
+}
+
+// Handle Execute the console command.
+func (receiver *ListCommand) Handle(ctx console.Context) error {
+	routes := filterRoutes(facades.Route.Routes(), ctx.Option("method"), ctx.Option("path"))
+
+	if ctx.Option("json") == "true" {
+		return renderJson(os.Stdout, routes)
+	}
+
+	if len(routes) == 0 {
+		color.Yellowln("Your application doesn't have any routes matching the given criteria.")
+
+		return nil
+	}
+
+	return renderTable(os.Stdout, routes)
+}
+
+func filterRoutes(routes []route.Info, method, path string) []route.Info {
+	var filtered []route.Info
+	for _, item := range routes {
+		if method != "" && !strings.EqualFold(item.Method, method) {
+			continue
+		}
+		if path != "" && !strings.Contains(item.Path, path) {
+			continue
+		}
+
+		filtered = append(filtered, item)
+	}
+
+	// The routes without a domain are listed first, then the routes of each domain.
+	sort.SliceStable(filtered, func(i, j int) bool {
+		if filtered[i].Domain != filtered[j].Domain {
+			return filtered[i].Domain < filtered[j].Domain
+		}
+		if filtered[i].Path == filtered[j].Path {
+			return filtered[i].Method < filtered[j].Method
+		}
+
+		return filtered[i].Path < filtered[j].Path
+	})
+
+	return filtered
+}
+
+func renderJson(w io.Writer, routes []route.Info) error {
+	if routes == nil {
+		routes = []route.Info{}
+	}
+
+	data, err := json.MarshalIndent(routes, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(w, string(data))
+
+	return err
+}
+
+func renderTable(w io.Writer, routes []route.Info) error {
+	writer := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(writer, "METHOD\tURI\tNAME\tHANDLER\tMIDDLEWARE")
+	for _, item := range routes {
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\n", item.Method, item.Domain+item.Path, item.Name, item.Handler, strings.Join(item.Middlewares, ", "))
+	}
+
+	return writer.Flush()
+}
@@ -0,0 +1,57 @@
+package console
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/contracts/route"
+)
+
+var routes = []route.Info{
+	{Method: "POST", Path: "/users", Name: "users.store", Handler: "controllers.(*UserController).Store", Middlewares: []string{"middleware.Cors"}},
+	{Method: "GET", Path: "/users/{id}", Name: "users.show", Handler: "controllers.(*UserController).Show", Middlewares: []string{"middleware.Cors", "middleware.Jwt"}},
+	{Method: "GET", Path: "/users", Name: "users.index", Handler: "controllers.(*UserController).Index"},
+	{Method: "GET", Path: "/photos", Handler: "controllers.(*PhotoController).Index"},
+	{Method: "GET", Domain: "{account}.goravel.dev", Path: "/users", Name: "account.users.index", Handler: "controllers.(*AccountController).Users"},
+}
+
+func TestFilterRoutes(t *testing.T) {
+	assert.Equal(t, []route.Info{routes[3], routes[2], routes[0], routes[1], routes[4]}, filterRoutes(routes, "", ""))
+	assert.Equal(t, []route.Info{routes[3], routes[2], routes[1], routes[4]}, filterRoutes(routes, "get", ""))
+	assert.Equal(t, []route.Info{routes[2], routes[1], routes[4]}, filterRoutes(routes, "GET", "users"))
+	assert.Nil(t, filterRoutes(routes, "DELETE", ""))
+}
+
+func TestRenderTable(t *testing.T) {
+	var buffer bytes.Buffer
+	assert.Nil(t, renderTable(&buffer, filterRoutes(routes, "", "users")))
+	assert.Equal(t, `METHOD   URI                           NAME                  HANDLER                                  MIDDLEWARE
+GET      /users                        users.index           controllers.(*UserController).Index      
+POST     /users                        users.store           controllers.(*UserController).Store      middleware.Cors
+GET      /users/{id}                   users.show            controllers.(*UserController).Show       middleware.Cors, middleware.Jwt
+GET      {account}.goravel.dev/users   account.users.index   controllers.(*AccountController).Users   
+`, buffer.String())
+}
+
+func TestRenderJson(t *testing.T) {
+	var buffer bytes.Buffer
+	assert.Nil(t, renderJson(&buffer, filterRoutes(routes, "DELETE", "")))
+	assert.Equal(t, "[]\n", buffer.String())
+
+	buffer.Reset()
+	assert.Nil(t, renderJson(&buffer, filterRoutes(routes, "POST", "")))
+	assert.Equal(t, `[
+  {
+    "method": "POST",
+    "path": "/users",
+    "name": "users.store",
+    "handler": "controllers.(*UserController).Store",
+    "middlewares": [
+      "middleware.Cors"
+    ]
+  }
+]
+`, buffer.String())
+}
@@ -0,0 +1,77 @@
+package console
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/gookit/color"
+
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+	"github.com/goravel/framework/contracts/route"
+	"github.com/goravel/framework/facades"
+	"github.com/goravel/framework/route/openapi"
+)
+
+type OpenApiGenerateCommand struct {
+}
+
+// Signature The name and signature of the console command.
+func (receiver *OpenApiGenerateCommand) Signature() string {
+	return "openapi:generate"
+}
+
+// Description The console command description.
+func (receiver *OpenApiGenerateCommand) Description() string {
+	return "Generate the OpenAPI spec from the registered routes"
+}
+
+// Extend The console command extend.
+func (receiver *OpenApiGenerateCommand) Extend() command.Extend {
+	return command.Extend{
+		Category: "openapi",
+		Flags: []command.Flag{
+			{
+				Name:    "output",
+				Value:   "openapi.json",
+				Aliases: []string{"o"},
+				Usage:   "the file of the spec, - prints the spec",
+			},
+		},
+	}
+}
+
+// Handle Execute the console command.
+func (receiver *OpenApiGenerateCommand) Handle(ctx console.Context) error {
+	output := ctx.Option("output")
+	if output == "-" {
+		return writeSpec(os.Stdout, facades.Route.Routes())
+	}
+
+	if err := os.MkdirAll(filepath.Dir(output), os.ModePerm); err != nil {
+		return err
+	}
+
+	file, err := os.Create(output)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if err := writeSpec(file, facades.Route.Routes()); err != nil {
+		return err
+	}
+
+	color.Greenln("OpenAPI spec generated successfully: " + output)
+
+	return nil
+}
+
+func writeSpec(w io.Writer, routes []route.Info) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(openapi.Generate(routes))
+}
@@ -0,0 +1,191 @@
+package route
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	httpcontract "github.com/goravel/framework/contracts/http"
+)
+
+type hostParamsKey struct{}
+
+// Domain The routes of a domain, the parameters of the host are captured by the pattern: {tenant}.example.com
+type Domain struct {
+	pattern string
+	regex   *regexp.Regexp
+	names   []string
+	// tree The routes of the domain, the gin driver uses it to determine if a route exists only, the handlers are served by handler.
+	tree *tree
+	// handler The engine serves the routes of the domain, it's nil for the net/http driver.
+	handler http.Handler
+}
+
+func newDomain(pattern string) *Domain {
+	pattern = strings.TrimSpace(pattern)
+
+	var names []string
+	var expression strings.Builder
+	last := 0
+	for _, index := range paramRegex.FindAllStringSubmatchIndex(pattern, -1) {
+		expression.WriteString(regexp.QuoteMeta(pattern[last:index[0]]))
+		expression.WriteString(`([^.]+)`)
+		names = append(names, pattern[index[2]:index[3]])
+		last = index[1]
+	}
+	expression.WriteString(regexp.QuoteMeta(pattern[last:]))
+
+	return &Domain{
+		pattern: pattern,
+		regex:   regexp.MustCompile("(?i)^" + expression.String() + "$"),
+		names:   names,
+		tree:    newTree(),
+	}
+}
+
+// match Get the parameters of the host if it matches the pattern, the port of the host is ignored.
+func (d *Domain) match(host string) (map[string]string, bool) {
+	if hostname, _, err := net.SplitHostPort(host); err == nil {
+		host = hostname
+	}
+
+	matches := d.regex.FindStringSubmatch(host)
+	if matches == nil {
+		return nil, false
+	}
+
+	params := make(map[string]string, len(d.names))
+	for i, name := range d.names {
+		params[name] = matches[i+1]
+	}
+
+	return params, true
+}
+
+func (d *Domain) Pattern() string {
+	return d.pattern
+}
+
+// Handler The engine serving the routes of the domain, it's set by the drivers which don't serve them through the tree.
+func (d *Domain) Handler() http.Handler {
+	return d.handler
+}
+
+func (d *Domain) SetHandler(handler http.Handler) {
+	d.handler = handler
+}
+
+// Add Register a route of the domain, so the requests of the route are found by Routes.FindDomain, the handlers
+// are served by Handler. The path uses the colon syntax: /users/:id
+func (d *Domain) Add(method, path string) {
+	d.tree.add(method, path, []httpcontract.HandlerFunc{})
+}
+
+// domains The domains of an engine, they are matched in the order they are registered.
+type domains struct {
+	mu    sync.RWMutex
+	items []*Domain
+	// create Initialize the domain when it's created, the gin driver creates the engine of the domain.
+	create func(*Domain)
+}
+
+// get Get the domain of the pattern, the domain is created if it doesn't exist.
+func (d *domains) get(pattern string) *Domain {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	item := newDomain(pattern)
+	for _, existing := range d.items {
+		if strings.EqualFold(existing.pattern, item.pattern) {
+			return existing
+		}
+	}
+
+	if d.create != nil {
+		d.create(item)
+	}
+	d.items = append(d.items, item)
+
+	return item
+}
+
+// find Get the domain which has a route matching the request, and the parameters of the host.
+func (d *domains) find(req *http.Request) (*Domain, map[string]string) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	for _, item := range d.items {
+		params, ok := item.match(req.Host)
+		if !ok {
+			continue
+		}
+		if _, _, found := item.tree.find(req.Method, req.URL.Path); found {
+			return item, params
+		}
+	}
+
+	return nil, nil
+}
+
+// allowed Get the methods of the routes matching the path of the request, in the main tree and the trees of the domains
+// matching the host.
+func (d *domains) allowed(req *http.Request, main *tree) []string {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	methods := main.allowed(req.URL.Path)
+	exist := make(map[string]bool, len(methods))
+	for _, method := range methods {
+		exist[method] = true
+	}
+	for _, item := range d.items {
+		if _, ok := item.match(req.Host); !ok {
+			continue
+		}
+		for _, method := range item.tree.allowed(req.URL.Path) {
+			if !exist[method] {
+				exist[method] = true
+				methods = append(methods, method)
+			}
+		}
+	}
+	sort.Strings(methods)
+
+	return methods
+}
+
+func (d *domains) all() []*Domain {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return append([]*Domain{}, d.items...)
+}
+
+// WithHostParams Add the parameters of the host to the context of the request.
+func WithHostParams(req *http.Request, params map[string]string) *http.Request {
+	return req.WithContext(context.WithValue(req.Context(), hostParamsKey{}, params))
+}
+
+// HostParams Get the parameters of the host added by WithHostParams.
+func HostParams(req *http.Request) map[string]string {
+	params, _ := req.Context().Value(hostParamsKey{}).(map[string]string)
+
+	return params
+}
+
+// mergeParams Merge the parameters of the host into the parameters of the path, the path parameters take precedence.
+func mergeParams(host, path map[string]string) map[string]string {
+	params := make(map[string]string, len(host)+len(path))
+	for key, value := range host {
+		params[key] = value
+	}
+	for key, value := range path {
+		params[key] = value
+	}
+
+	return params
+}
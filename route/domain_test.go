@@ -0,0 +1,103 @@
+package route_test
+
+import (
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/contracts/http"
+	contractsroute "github.com/goravel/framework/contracts/route"
+	"github.com/goravel/framework/route"
+	"github.com/goravel/framework/route/gin"
+	"github.com/goravel/framework/testing/mock"
+)
+
+func TestDomain(t *testing.T) {
+	mockConfig := mock.Config()
+	mockConfig.On("GetBool", "app.debug").Return(false)
+
+	engines := map[string]contractsroute.Engine{"gin": gin.NewRoute(), "nethttp": route.NewNetHttp()}
+	for name, engine := range engines {
+		engine.GlobalMiddleware(func(ctx http.Context) {
+			ctx.Response().Header("X-Global", "1")
+			ctx.Request().Next()
+		})
+		engine.Domain("{tenant}.example.com").Prefix("api").Group(func(router contractsroute.Route) {
+			router.Get("/users/{id}", func(ctx http.Context) {
+				ctx.Response().String(nethttp.StatusOK, "tenant: "+ctx.Request().Input("tenant")+", user: "+ctx.Request().Input("id"))
+			}).Name("tenant.users.show")
+			router.Domain("admin.example.com").Get("/dashboard", func(ctx http.Context) {
+				ctx.Response().String(nethttp.StatusOK, "admin")
+			})
+		})
+		engine.Get("/api/users/{id}", func(ctx http.Context) {
+			ctx.Response().String(nethttp.StatusOK, "main: "+ctx.Request().Input("id"))
+		})
+		engine.Get("/about", func(ctx http.Context) {
+			ctx.Response().String(nethttp.StatusOK, "about")
+		})
+
+		tests := []struct {
+			host         string
+			url          string
+			expectCode   int
+			expectBody   string
+			expectGlobal string
+		}{
+			{host: "acme.example.com", url: "/api/users/1", expectCode: nethttp.StatusOK, expectBody: "tenant: acme, user: 1", expectGlobal: "1"},
+			{host: "ACME.Example.com:8080", url: "/api/users/2", expectCode: nethttp.StatusOK, expectBody: "tenant: ACME, user: 2", expectGlobal: "1"},
+			{host: "admin.example.com", url: "/api/dashboard", expectCode: nethttp.StatusOK, expectBody: "admin", expectGlobal: "1"},
+			{host: "acme.example.com", url: "/api/dashboard", expectCode: nethttp.StatusNotFound, expectGlobal: "1"},
+			{host: "example.com", url: "/api/users/1", expectCode: nethttp.StatusOK, expectBody: "main: 1", expectGlobal: "1"},
+			{host: "a.b.example.com", url: "/api/users/1", expectCode: nethttp.StatusOK, expectBody: "main: 1", expectGlobal: "1"},
+			{host: "acme.example.com", url: "/about", expectCode: nethttp.StatusOK, expectBody: "about", expectGlobal: "1"},
+		}
+
+		for _, test := range tests {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(nethttp.MethodGet, test.url, nil)
+			req.Host = test.host
+			req.Header.Set("Accept", "application/json")
+			engine.ServeHTTP(w, req)
+
+			assert.Equal(t, test.expectCode, w.Code, name+" "+test.host+test.url)
+			if test.expectBody != "" {
+				assert.Equal(t, test.expectBody, w.Body.String(), name+" "+test.host+test.url)
+			}
+			assert.Equal(t, test.expectGlobal, w.Header().Get("X-Global"), name+" "+test.host+test.url)
+		}
+
+		var domains []string
+		for _, info := range engine.Routes() {
+			if info.Name == "tenant.users.show" {
+				domains = append(domains, info.Domain)
+			}
+		}
+		assert.Equal(t, []string{"{tenant}.example.com"}, domains, name)
+	}
+}
+
+func TestDomainMatch(t *testing.T) {
+	routes := route.NewRoutes()
+	item := routes.Domain("{tenant}.{region}.example.com")
+	item.Add(nethttp.MethodGet, "/")
+
+	find := func(host string) (*route.Domain, map[string]string) {
+		req := httptest.NewRequest(nethttp.MethodGet, "/", nil)
+		req.Host = host
+
+		return routes.FindDomain(req)
+	}
+
+	found, params := find("acme.eu.example.com:443")
+	assert.Equal(t, item, found)
+	assert.Equal(t, map[string]string{"tenant": "acme", "region": "eu"}, params)
+
+	found, _ = find("acme.example.com")
+	assert.Nil(t, found)
+
+	found, _ = find("acme.eu.example.com.evil.com")
+	assert.Nil(t, found)
+}
@@ -0,0 +1,28 @@
+package route
+
+import (
+	"net/http"
+
+	"github.com/goravel/framework/contracts/foundation"
+	httpcontract "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/facades"
+	frameworkhttp "github.com/goravel/framework/http"
+)
+
+// NotFound The default fallback handler, the error is rendered as JSON or HTML according to the Accept header.
+func NotFound(ctx httpcontract.Context) {
+	exceptionHandler().Render(ctx, frameworkhttp.NewHttpError(http.StatusNotFound))
+}
+
+// MethodNotAllowed The default handler of the routes which don't allow the method of the request.
+func MethodNotAllowed(ctx httpcontract.Context) {
+	exceptionHandler().Render(ctx, frameworkhttp.NewHttpError(http.StatusMethodNotAllowed))
+}
+
+func exceptionHandler() foundation.ExceptionHandler {
+	if facades.ExceptionHandler != nil {
+		return facades.ExceptionHandler
+	}
+
+	return frameworkhttp.NewExceptionHandler()
+}
@@ -0,0 +1,77 @@
+package route_test
+
+import (
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/contracts/http"
+	contractsroute "github.com/goravel/framework/contracts/route"
+	"github.com/goravel/framework/route"
+	"github.com/goravel/framework/route/gin"
+	"github.com/goravel/framework/testing/mock"
+)
+
+func TestFallback(t *testing.T) {
+	mockConfig := mock.Config()
+	mockConfig.On("GetBool", "app.debug").Return(false)
+
+	engines := func() map[string]contractsroute.Engine {
+		return map[string]contractsroute.Engine{"gin": gin.NewRoute(), "nethttp": route.NewNetHttp()}
+	}
+
+	for name, engine := range engines() {
+		engine.GlobalMiddleware(func(ctx http.Context) {
+			ctx.Response().Header("X-Global", "1")
+			ctx.Request().Next()
+		})
+		engine.Get("/users/{id}", func(ctx http.Context) {})
+		engine.Put("/users/{id}", func(ctx http.Context) {})
+
+		tests := []struct {
+			method       string
+			url          string
+			expectCode   int
+			expectBody   string
+			expectAllow  string
+			expectGlobal string
+		}{
+			{method: nethttp.MethodGet, url: "/missing", expectCode: nethttp.StatusNotFound, expectBody: `{"message":"Not Found"}`, expectGlobal: "1"},
+			{method: nethttp.MethodPost, url: "/users/1", expectCode: nethttp.StatusMethodNotAllowed, expectBody: `{"message":"Method Not Allowed"}`, expectAllow: "GET, PUT", expectGlobal: "1"},
+		}
+
+		for _, test := range tests {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(test.method, test.url, nil)
+			req.Header.Set("Accept", "application/json")
+			engine.ServeHTTP(w, req)
+
+			assert.Equal(t, test.expectCode, w.Code, name)
+			assert.Equal(t, test.expectBody, w.Body.String(), name)
+			assert.Equal(t, test.expectAllow, w.Header().Get("Allow"), name)
+			assert.Equal(t, test.expectGlobal, w.Header().Get("X-Global"), name)
+		}
+	}
+
+	for name, engine := range engines() {
+		engine.Get("/users/{id}", func(ctx http.Context) {})
+		engine.Fallback(func(ctx http.Context) {
+			ctx.Response().String(nethttp.StatusNotFound, "fallback")
+		})
+		engine.MethodNotAllowed(func(ctx http.Context) {
+			ctx.Response().String(nethttp.StatusMethodNotAllowed, "not allowed: "+ctx.Response().Writer().Header().Get("Allow"))
+		})
+
+		w := httptest.NewRecorder()
+		engine.ServeHTTP(w, httptest.NewRequest(nethttp.MethodGet, "/missing", nil))
+		assert.Equal(t, nethttp.StatusNotFound, w.Code, name)
+		assert.Equal(t, "fallback", w.Body.String(), name)
+
+		w = httptest.NewRecorder()
+		engine.ServeHTTP(w, httptest.NewRequest(nethttp.MethodDelete, "/users/1", nil))
+		assert.Equal(t, nethttp.StatusMethodNotAllowed, w.Code, name)
+		assert.Equal(t, "not allowed: GET", w.Body.String(), name)
+	}
+}
@@ -0,0 +1,79 @@
+package gin
+
+import (
+	"context"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/goravel/framework/contracts/http"
+)
+
+// Context The http.Context of the gin driver.
+type Context struct {
+	instance *gin.Context
+}
+
+func NewContext(ctx *gin.Context) http.Context {
+	return &Context{ctx}
+}
+
+func (c *Context) Request() http.Request {
+	return NewRequest(c.instance)
+}
+
+func (c *Context) Response() http.Response {
+	return NewResponse(c.instance)
+}
+
+func (c *Context) WithValue(key string, value interface{}) {
+	c.instance.Set(key, value)
+}
+
+func (c *Context) WithContext(ctx context.Context) {
+	c.instance.Request = c.instance.Request.WithContext(ctx)
+}
+
+func (c *Context) Context() context.Context {
+	ctx := context.Background()
+	for key, value := range c.instance.Keys {
+		ctx = context.WithValue(ctx, key, value)
+	}
+
+	return ctx
+}
+
+// Deadline The deadline of the request, gin.Context doesn't use the context of the request.
+func (c *Context) Deadline() (deadline time.Time, ok bool) {
+	if c.instance.Request == nil {
+		return c.instance.Deadline()
+	}
+
+	return c.instance.Request.Context().Deadline()
+}
+
+// Done It's closed when the client disconnects, the handler returns or the deadline of the Timeout middleware passes,
+// Shutdown doesn't close it, it waits for the pending requests instead.
+func (c *Context) Done() <-chan struct{} {
+	if c.instance.Request == nil {
+		return c.instance.Done()
+	}
+
+	return c.instance.Request.Context().Done()
+}
+
+func (c *Context) Err() error {
+	if c.instance.Request == nil {
+		return c.instance.Err()
+	}
+
+	return c.instance.Request.Context().Err()
+}
+
+func (c *Context) Value(key interface{}) interface{} {
+	return c.instance.Value(key)
+}
+
+func (c *Context) Instance() *gin.Context {
+	return c.instance
+}
@@ -0,0 +1,17 @@
+package gin
+
+import (
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContext(t *testing.T) {
+	httpCtx := NewContext(&gin.Context{})
+	httpCtx.WithValue("Hello", "world")
+	httpCtx.WithValue("Hi", "Goravel")
+	ctx := httpCtx.Context()
+	assert.Equal(t, ctx.Value("Hello").(string), "world")
+	assert.Equal(t, ctx.Value("Hi").(string), "Goravel")
+}
@@ -0,0 +1,169 @@
+package gin
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+
+	contractsfilesystem "github.com/goravel/framework/contracts/filesystem"
+	contractshttp "github.com/goravel/framework/contracts/http"
+	contractssession "github.com/goravel/framework/contracts/session"
+	contractsvalidation "github.com/goravel/framework/contracts/validation"
+	"github.com/goravel/framework/filesystem"
+	frameworkhttp "github.com/goravel/framework/http"
+)
+
+const sessionKey = "GoravelSession"
+
+// Request The http.Request of the gin driver.
+type Request struct {
+	instance *gin.Context
+}
+
+func NewRequest(instance *gin.Context) contractshttp.Request {
+	return &Request{instance}
+}
+
+func (r *Request) Input(key string) string {
+	return r.instance.Param(key)
+}
+
+func (r *Request) Query(key, defaultValue string) string {
+	return r.instance.DefaultQuery(key, defaultValue)
+}
+
+func (r *Request) Form(key, defaultValue string) string {
+	return r.instance.DefaultPostForm(key, defaultValue)
+}
+
+func (r *Request) Cookie(name string, defaultValue ...string) string {
+	return frameworkhttp.GetCookie(r.instance.Request, name, defaultValue...)
+}
+
+func (r *Request) Bind(obj interface{}) error {
+	// gin binds application/x-yaml and application/x-protobuf only, the aliases are bound the same as the net/http driver.
+	switch frameworkhttp.FormatOf(r.instance.ContentType()) {
+	case frameworkhttp.FormatYaml:
+		return r.instance.ShouldBindWith(obj, binding.YAML)
+	case frameworkhttp.FormatProtoBuf:
+		return frameworkhttp.DecodeProtoBuf(r.instance.Request.Body, obj)
+	}
+
+	return r.instance.ShouldBind(obj)
+}
+
+func (r *Request) File(name string) (contractsfilesystem.File, error) {
+	file, err := r.instance.FormFile(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return filesystem.NewFileFromRequest(file)
+}
+
+func (r *Request) Files(name string) ([]contractsfilesystem.File, error) {
+	form, err := r.instance.MultipartForm()
+	if err != nil {
+		return nil, err
+	}
+
+	return frameworkhttp.FilesFromHeaders(form.File[name])
+}
+
+func (r *Request) StreamFiles(handler func(name string, file contractsfilesystem.StreamedFile) error) error {
+	return frameworkhttp.StreamFiles(r.instance.Request, handler)
+}
+
+func (r *Request) Header(key, defaultValue string) string {
+	header := r.instance.GetHeader(key)
+	if header != "" {
+		return header
+	}
+
+	return defaultValue
+}
+
+func (r *Request) Headers() http.Header {
+	return r.instance.Request.Header
+}
+
+func (r *Request) Method() string {
+	return r.instance.Request.Method
+}
+
+func (r *Request) Url() string {
+	return r.instance.Request.RequestURI
+}
+
+func (r *Request) FullUrl() string {
+	prefix := "https://"
+	if r.instance.Request.TLS == nil {
+		prefix = "http://"
+	}
+
+	if r.instance.Request.Host == "" {
+		return ""
+	}
+
+	return prefix + r.instance.Request.Host + r.instance.Request.RequestURI
+}
+
+func (r *Request) Abort() {
+	r.instance.Abort()
+}
+
+func (r *Request) AbortWithStatus(code int) {
+	r.instance.AbortWithStatus(code)
+}
+
+func (r *Request) AbortWithStatusJson(code int, jsonObj interface{}) {
+	r.instance.AbortWithStatusJSON(code, jsonObj)
+}
+
+func (r *Request) Next() {
+	r.instance.Next()
+}
+
+func (r *Request) Path() string {
+	return r.instance.Request.URL.Path
+}
+
+func (r *Request) Ip() string {
+	return r.instance.ClientIP()
+}
+
+func (r *Request) Origin() *http.Request {
+	return r.instance.Request
+}
+
+func (r *Request) Response() contractshttp.Response {
+	return NewResponse(r.instance)
+}
+
+func (r *Request) Session() contractssession.Session {
+	if session, exist := r.instance.Get(sessionKey); exist {
+		return session.(contractssession.Session)
+	}
+
+	return nil
+}
+
+func (r *Request) SetSession(session contractssession.Session) contractshttp.Request {
+	r.instance.Set(sessionKey, session)
+
+	return r
+}
+
+func (r *Request) Validate(rules map[string]string, options ...contractsvalidation.Option) (contractsvalidation.Validator, error) {
+	params := make(map[string]string, len(r.instance.Params))
+	for _, param := range r.instance.Params {
+		params[param.Key] = param.Value
+	}
+
+	return frameworkhttp.Validate(r.instance.Request, params, rules, options...)
+}
+
+func (r *Request) ValidateRequest(request contractshttp.FormRequest) (contractsvalidation.Errors, error) {
+	return frameworkhttp.ValidateRequest(r, request)
+}
@@ -0,0 +1,154 @@
+package gin
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/facades"
+	testingmock "github.com/goravel/framework/testing/mock"
+	"github.com/goravel/framework/validation"
+)
+
+type CreateUser struct {
+	ID    int    `form:"id"`
+	Name  string `form:"name"`
+	Email string `form:"email"`
+	Page  int    `form:"page"`
+}
+
+func (r *CreateUser) Authorize() bool {
+	return true
+}
+
+func (r *CreateUser) Rules() map[string]string {
+	return map[string]string{
+		"id":    "required|integer",
+		"name":  "required|max:10",
+		"email": "required|email",
+		"page":  "integer",
+	}
+}
+
+func (r *CreateUser) Messages() map[string]string {
+	return map[string]string{
+		"email.email": "Invalid :attribute.",
+	}
+}
+
+func (r *CreateUser) Attributes() map[string]string {
+	return map[string]string{
+		"email": "email address",
+	}
+}
+
+func newRequest(method, target, contentType, body string) *Request {
+	ginCtx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ginCtx.Request = httptest.NewRequest(method, target, strings.NewReader(body))
+	ginCtx.Request.Header.Set("Content-Type", contentType)
+	ginCtx.Params = gin.Params{{Key: "id", Value: "1"}}
+
+	return &Request{instance: ginCtx}
+}
+
+func TestValidate(t *testing.T) {
+	facades.Validation = validation.NewApplication()
+
+	request := newRequest(http.MethodPost, "/users/1?page=2", "application/x-www-form-urlencoded", "name=Goravel&email=goravel")
+	validator, err := request.Validate(map[string]string{"name": "required", "email": "email", "page": "integer"})
+	assert.Nil(t, err)
+	assert.True(t, validator.Fails())
+	assert.Equal(t, map[string]string{"email": "The email must be a valid email address."}, validator.Errors().Get("email"))
+
+	_, err = request.Validate(map[string]string{})
+	assert.NotNil(t, err)
+}
+
+func TestValidateRequest(t *testing.T) {
+	facades.Validation = validation.NewApplication()
+
+	request := newRequest(http.MethodPost, "/users/1", "application/json", `{"name": "Goravel", "email": "goravel"}`)
+	var createUser CreateUser
+	errors, err := request.ValidateRequest(&createUser)
+	assert.Nil(t, err)
+	assert.Equal(t, "Invalid email address.", errors.One("email"))
+
+	request = newRequest(http.MethodPost, "/users/1?page=2", "application/json", `{"name": "Goravel", "email": "hello@goravel.dev"}`)
+	errors, err = request.ValidateRequest(&createUser)
+	assert.Nil(t, err)
+	assert.Nil(t, errors)
+	assert.Equal(t, CreateUser{ID: 1, Name: "Goravel", Email: "hello@goravel.dev", Page: 2}, createUser)
+}
+
+func TestBindFormats(t *testing.T) {
+	data, _ := proto.Marshal(wrapperspb.String("goravel"))
+
+	tests := []struct {
+		contentType string
+		body        []byte
+		obj         func() interface{}
+		expect      interface{}
+	}{
+		{contentType: "application/xml", body: []byte("<Book><title>Goravel</title></Book>"), obj: func() interface{} { return &Book{} }, expect: &Book{Title: "Goravel"}},
+		{contentType: "application/x-yaml", body: []byte("title: Goravel"), obj: func() interface{} { return &Book{} }, expect: &Book{Title: "Goravel"}},
+		{contentType: "application/yaml; charset=utf-8", body: []byte("title: Goravel"), obj: func() interface{} { return &Book{} }, expect: &Book{Title: "Goravel"}},
+		{contentType: "application/x-protobuf", body: data, obj: func() interface{} { return &wrapperspb.StringValue{} }, expect: "goravel"},
+	}
+
+	for _, test := range tests {
+		ginCtx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ginCtx.Request = httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(test.body))
+		ginCtx.Request.Header.Set("Content-Type", test.contentType)
+		obj := test.obj()
+
+		assert.Nil(t, NewRequest(ginCtx).Bind(obj), test.contentType)
+		if message, ok := obj.(*wrapperspb.StringValue); ok {
+			assert.Equal(t, test.expect, message.GetValue(), test.contentType)
+		} else {
+			assert.Equal(t, test.expect, obj, test.contentType)
+		}
+	}
+}
+
+func TestRequestFiles(t *testing.T) {
+	mockConfig := testingmock.Config()
+	mockConfig.On("GetString", "filesystems.default").Return("local")
+
+	newRequest := func() contractshttp.Request {
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+		part, _ := writer.CreateFormFile("photos", "beach.png")
+		_, _ = part.Write([]byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR"))
+		part, _ = writer.CreateFormFile("photos", "notes.txt")
+		_, _ = part.Write([]byte("goravel"))
+		_ = writer.Close()
+
+		ginCtx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ginCtx.Request = httptest.NewRequest(http.MethodPost, "/upload", body)
+		ginCtx.Request.Header.Set("Content-Type", writer.FormDataContentType())
+
+		return NewRequest(ginCtx)
+	}
+
+	files, err := newRequest().Files("photos")
+	assert.Nil(t, err)
+	assert.Len(t, files, 2)
+	assert.Equal(t, "beach.png", files[0].GetClientOriginalName())
+	assert.Equal(t, "notes.txt", files[1].GetClientOriginalName())
+
+	mimeType, err := files[0].MimeType()
+	assert.Nil(t, err)
+	assert.Equal(t, "image/png", mimeType)
+
+	_, err = newRequest().Files("missing")
+	assert.ErrorIs(t, err, http.ErrMissingFile)
+}
@@ -0,0 +1,172 @@
+package gin
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	httpcontract "github.com/goravel/framework/contracts/http"
+	frameworkhttp "github.com/goravel/framework/http"
+)
+
+// Response The http.Response of the gin driver.
+type Response struct {
+	instance *gin.Context
+}
+
+func NewResponse(instance *gin.Context) httpcontract.Response {
+	return &Response{instance: instance}
+}
+
+func (r *Response) String(code int, format string, values ...interface{}) {
+	r.instance.String(code, format, values...)
+}
+
+func (r *Response) Json(code int, obj interface{}) {
+	r.instance.JSON(code, obj)
+}
+
+func (r *Response) Xml(code int, obj interface{}) {
+	r.instance.XML(code, obj)
+}
+
+func (r *Response) Yaml(code int, obj interface{}) {
+	r.instance.YAML(code, obj)
+}
+
+func (r *Response) ProtoBuf(code int, obj interface{}) {
+	frameworkhttp.WriteProtoBuf(r.instance.Writer, code, obj)
+}
+
+func (r *Response) Negotiate(code int, obj interface{}) {
+	frameworkhttp.WriteNegotiation(r, r.instance.Request, code, obj)
+}
+
+func (r *Response) File(filepath string) {
+	r.instance.File(filepath)
+}
+
+func (r *Response) Download(filepath, filename string) {
+	r.instance.FileAttachment(filepath, filename)
+}
+
+func (r *Response) View(view string, data interface{}) {
+	frameworkhttp.WriteView(r.instance.Writer, view, data)
+}
+
+func (r *Response) Stream(step func(w io.Writer) bool) {
+	frameworkhttp.WriteStream(r.instance.Request.Context().Done(), r.instance.Writer, step)
+}
+
+func (r *Response) SSE(events <-chan httpcontract.Event) {
+	frameworkhttp.WriteSSE(r.instance.Request.Context().Done(), r.instance.Writer, events)
+}
+
+func (r *Response) Success() httpcontract.ResponseSuccess {
+	return NewSuccess(r.instance)
+}
+
+func (r *Response) Header(key, value string) httpcontract.Response {
+	r.instance.Header(key, value)
+
+	return r
+}
+
+func (r *Response) Cookie(cookie httpcontract.Cookie) httpcontract.Response {
+	frameworkhttp.SetCookie(r.instance.Writer, cookie)
+
+	return r
+}
+
+func (r *Response) WithoutCookie(name string) httpcontract.Response {
+	frameworkhttp.RemoveCookie(r.instance.Writer, name)
+
+	return r
+}
+
+func (r *Response) Writer() http.ResponseWriter {
+	return r.instance.Writer
+}
+
+func (r *Response) SetWriter(w http.ResponseWriter) {
+	if writer, ok := w.(gin.ResponseWriter); ok {
+		r.instance.Writer = writer
+
+		return
+	}
+
+	r.instance.Writer = &responseWriter{ResponseWriter: r.instance.Writer, writer: w}
+}
+
+type Success struct {
+	instance *gin.Context
+}
+
+func NewSuccess(instance *gin.Context) httpcontract.ResponseSuccess {
+	return &Success{instance}
+}
+
+func (r *Success) String(format string, values ...interface{}) {
+	r.instance.String(http.StatusOK, format, values...)
+}
+
+func (r *Success) Json(obj interface{}) {
+	r.instance.JSON(http.StatusOK, obj)
+}
+
+// responseWriter Adapt a http.ResponseWriter to gin.ResponseWriter, the writes go to the writer,
+// and the others, like Status and Size, are still recorded by the origin gin.ResponseWriter.
+// Written is tracked by the adapter, since the writer may buffer the response, e.g. the Gzip and ETag middlewares,
+// then nothing reaches the origin gin.ResponseWriter until the middleware returns.
+type responseWriter struct {
+	gin.ResponseWriter
+	writer  http.ResponseWriter
+	written bool
+}
+
+func (w *responseWriter) Header() http.Header {
+	return w.writer.Header()
+}
+
+// WriteHeader The status is recorded by the origin gin.ResponseWriter too, so Status is right before the writer sends it.
+func (w *responseWriter) WriteHeader(code int) {
+	if !w.written {
+		w.ResponseWriter.WriteHeader(code)
+	}
+	w.written = true
+	w.writer.WriteHeader(code)
+}
+
+// WriteHeaderNow The header is only sent via the writer, the writer may buffer it, e.g. the Gzip and ETag middlewares,
+// so the origin gin.ResponseWriter mustn't send it by itself.
+func (w *responseWriter) WriteHeaderNow() {
+	if !w.written {
+		w.written = true
+		w.writer.WriteHeader(w.Status())
+	}
+}
+
+func (w *responseWriter) Write(data []byte) (int, error) {
+	w.written = true
+
+	return w.writer.Write(data)
+}
+
+func (w *responseWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+func (w *responseWriter) Written() bool {
+	return w.written
+}
+
+func (w *responseWriter) Flush() {
+	w.written = true
+	if flusher, ok := w.writer.(http.Flusher); ok {
+		flusher.Flush()
+
+		return
+	}
+	w.ResponseWriter.Flush()
+}
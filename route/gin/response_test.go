@@ -0,0 +1,304 @@
+package gin
+
+import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/contracts/route"
+	"github.com/goravel/framework/http/middleware"
+	testingmock "github.com/goravel/framework/testing/mock"
+)
+
+type Book struct {
+	Title string `json:"title" xml:"title" yaml:"title" form:"title"`
+}
+
+type headerWriter struct {
+	http.ResponseWriter
+}
+
+func (w *headerWriter) WriteHeader(code int) {
+	w.Header().Set("X-Wrapped", "1")
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func TestResponseSetWriter(t *testing.T) {
+	gin.SetMode(gin.ReleaseMode)
+	engine := gin.New()
+	engine.Use(func(ctx *gin.Context) {
+		response := NewResponse(ctx)
+		response.SetWriter(&headerWriter{ResponseWriter: response.Writer()})
+		ctx.Next()
+	})
+	engine.GET("/string", func(ctx *gin.Context) {
+		NewResponse(ctx).String(http.StatusCreated, "goravel")
+	})
+	engine.GET("/abort", func(ctx *gin.Context) {
+		NewRequest(ctx).AbortWithStatus(http.StatusForbidden)
+	})
+
+	tests := []struct {
+		url        string
+		expectCode int
+		expectBody string
+	}{
+		{url: "/string", expectCode: http.StatusCreated, expectBody: "goravel"},
+		{url: "/abort", expectCode: http.StatusForbidden},
+	}
+
+	for _, test := range tests {
+		w := httptest.NewRecorder()
+		engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, test.url, nil))
+		assert.Equal(t, test.expectCode, w.Code, test.url)
+		assert.Equal(t, test.expectBody, w.Body.String(), test.url)
+		assert.Equal(t, "1", w.Header().Get("X-Wrapped"), test.url)
+	}
+}
+
+func TestResponseView(t *testing.T) {
+	mockView := testingmock.View()
+	mockView.On("Render", mock.Anything, "users/show", map[string]interface{}{"name": "goravel"}).Run(func(args mock.Arguments) {
+		_, _ = args.Get(0).(io.Writer).Write([]byte("<p>goravel</p>"))
+	}).Return(nil).Once()
+	mockView.On("Render", mock.Anything, "users/missing", nil).Return(errors.New("[view] the view users/missing doesn't exist")).Once()
+	testingmock.Log()
+
+	gin.SetMode(gin.ReleaseMode)
+	engine := gin.New()
+	engine.GET("/show", func(ctx *gin.Context) {
+		NewResponse(ctx).View("users/show", map[string]interface{}{"name": "goravel"})
+	})
+	engine.GET("/missing", func(ctx *gin.Context) {
+		NewResponse(ctx).View("users/missing", nil)
+	})
+
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/show", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/html; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Equal(t, "<p>goravel</p>", w.Body.String())
+
+	w = httptest.NewRecorder()
+	engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/missing", nil))
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+
+	mockView.AssertExpectations(t)
+}
+
+// TestResponseWithMiddlewares The Gzip and ETag middlewares replace the writer, the header must be sent through them.
+func TestResponseWithMiddlewares(t *testing.T) {
+	mockConfig := testingmock.Config()
+	mockConfig.On("GetBool", "app.debug").Return(false)
+	mockConfig.On("GetInt", "http.compression.level", -1).Return(-1)
+	mockConfig.On("GetInt", "http.compression.min_length", 1024).Return(1)
+	mockConfig.On("Get", "http.compression.content_types", mock.Anything).Return([]string{"text/plain"})
+
+	engine := NewRoute()
+	engine.Middleware(middleware.Gzip()).Group(func(router route.Route) {
+		router.Get("/gzip", func(ctx contractshttp.Context) {
+			ctx.Response().String(http.StatusOK, "goravel")
+		})
+		router.Get("/gzip/abort", func(ctx contractshttp.Context) {
+			ctx.Request().AbortWithStatus(http.StatusForbidden)
+		})
+	})
+	engine.Middleware(middleware.ETag()).Group(func(router route.Route) {
+		router.Get("/etag", func(ctx contractshttp.Context) {
+			ctx.Response().String(http.StatusOK, "goravel")
+		})
+		router.Get("/etag/abort", func(ctx contractshttp.Context) {
+			ctx.Request().AbortWithStatus(http.StatusForbidden)
+		})
+	})
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/gzip", nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+	engine.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "gzip", w.Header().Get("Content-Encoding"))
+	reader, err := gzip.NewReader(w.Body)
+	assert.Nil(t, err)
+	body, err := io.ReadAll(reader)
+	assert.Nil(t, err)
+	assert.Equal(t, "goravel", string(body))
+
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodGet, "/gzip/abort", nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+	engine.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Equal(t, "", w.Header().Get("Content-Encoding"))
+
+	w = httptest.NewRecorder()
+	engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/etag", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "goravel", w.Body.String())
+	etag := w.Header().Get("ETag")
+	assert.NotEmpty(t, etag)
+
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodGet, "/etag", nil)
+	req.Header.Set("If-None-Match", etag)
+	engine.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusNotModified, w.Code)
+	assert.Equal(t, "", w.Body.String())
+
+	w = httptest.NewRecorder()
+	engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/etag/abort", nil))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Equal(t, "", w.Header().Get("ETag"))
+}
+
+// TestResponseWrittenWithMiddlewares The Gzip and ETag middlewares buffer the response, the response written to them
+// must be seen by the Timeout and Recovery middlewares, otherwise their error responses are appended to it.
+func TestResponseWrittenWithMiddlewares(t *testing.T) {
+	mockConfig := testingmock.Config()
+	mockConfig.On("GetBool", "app.debug").Return(false)
+	mockConfig.On("GetInt", "http.compression.level", -1).Return(-1)
+	mockConfig.On("GetInt", "http.compression.min_length", 1024).Return(1024)
+	mockConfig.On("Get", "http.compression.content_types", mock.Anything).Return([]string{"text/plain"})
+	testingmock.Log()
+
+	late := func(ctx contractshttp.Context) {
+		<-ctx.Done()
+		ctx.Response().String(http.StatusOK, "late")
+	}
+	partial := func(ctx contractshttp.Context) {
+		ctx.Response().String(http.StatusOK, "partial")
+		panic("goravel")
+	}
+
+	engine := NewRoute()
+	engine.Middleware(middleware.Gzip(), middleware.Timeout(10*time.Millisecond)).Get("/gzip/timeout", late)
+	engine.Middleware(middleware.ETag(), middleware.Timeout(10*time.Millisecond)).Get("/etag/timeout", late)
+	engine.Middleware(middleware.Gzip(), middleware.Recovery()).Get("/gzip/recovery", partial)
+	engine.Middleware(middleware.ETag(), middleware.Recovery()).Get("/etag/recovery", partial)
+
+	tests := []struct {
+		url        string
+		expectBody string
+	}{
+		{url: "/gzip/timeout", expectBody: "late"},
+		{url: "/etag/timeout", expectBody: "late"},
+		{url: "/gzip/recovery", expectBody: "partial"},
+		{url: "/etag/recovery", expectBody: "partial"},
+	}
+
+	for _, test := range tests {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, test.url, nil)
+		req.Header.Set("Accept", "application/json")
+		req.Header.Set("Accept-Encoding", "gzip")
+		engine.ServeHTTP(w, req)
+		assert.Equal(t, test.expectBody, w.Body.String(), test.url)
+	}
+}
+
+func TestResponseFormats(t *testing.T) {
+	book := Book{Title: "Goravel"}
+
+	tests := []struct {
+		name              string
+		accept            string
+		write             func(response contractshttp.Response)
+		expectCode        int
+		expectContentType string
+		expectBody        string
+	}{
+		{
+			name:              "xml",
+			write:             func(response contractshttp.Response) { response.Xml(http.StatusOK, book) },
+			expectCode:        http.StatusOK,
+			expectContentType: "application/xml; charset=utf-8",
+			expectBody:        "<Book><title>Goravel</title></Book>",
+		},
+		{
+			name:              "yaml",
+			write:             func(response contractshttp.Response) { response.Yaml(http.StatusCreated, book) },
+			expectCode:        http.StatusCreated,
+			expectContentType: "application/x-yaml; charset=utf-8",
+			expectBody:        "title: Goravel\n",
+		},
+		{
+			name:              "negotiate xml",
+			accept:            "application/xml",
+			write:             func(response contractshttp.Response) { response.Negotiate(http.StatusOK, book) },
+			expectCode:        http.StatusOK,
+			expectContentType: "application/xml; charset=utf-8",
+			expectBody:        "<Book><title>Goravel</title></Book>",
+		},
+		{
+			name:              "not acceptable",
+			accept:            "text/html",
+			write:             func(response contractshttp.Response) { response.Negotiate(http.StatusOK, book) },
+			expectCode:        http.StatusNotAcceptable,
+			expectContentType: "text/plain; charset=utf-8",
+			expectBody:        "Not Acceptable",
+		},
+	}
+
+	for _, test := range tests {
+		w := httptest.NewRecorder()
+		ginCtx, _ := gin.CreateTestContext(w)
+		ginCtx.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+		ginCtx.Request.Header.Set("Accept", test.accept)
+		test.write(NewResponse(ginCtx))
+
+		assert.Equal(t, test.expectCode, w.Code, test.name)
+		assert.Equal(t, test.expectContentType, w.Header().Get("Content-Type"), test.name)
+		assert.Equal(t, test.expectBody, w.Body.String(), test.name)
+	}
+}
+
+func TestResponseStream(t *testing.T) {
+	w := httptest.NewRecorder()
+	ginCtx, _ := gin.CreateTestContext(w)
+	ginCtx.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+
+	i := 0
+	NewResponse(ginCtx).Stream(func(w io.Writer) bool {
+		i++
+		_, _ = fmt.Fprintf(w, "chunk %d\n", i)
+
+		return i < 3
+	})
+
+	assert.Equal(t, "chunk 1\nchunk 2\nchunk 3\n", w.Body.String())
+	assert.True(t, w.Flushed)
+}
+
+func TestResponseSSEStopsWhenClientDisconnects(t *testing.T) {
+	mockConfig := testingmock.Config()
+	mockConfig.On("GetInt", "http.sse_keep_alive", 15).Return(15)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ginCtx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ginCtx.Request = httptest.NewRequest(http.MethodGet, "/", nil).WithContext(ctx)
+
+	done := make(chan struct{})
+	go func() {
+		NewResponse(ginCtx).SSE(make(chan contractshttp.Event))
+		close(done)
+	}()
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("SSE doesn't stop when the client disconnects")
+	}
+	assert.NotNil(t, NewContext(ginCtx).Err())
+}
@@ -0,0 +1,403 @@
+package gin
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gookit/color"
+
+	httpcontract "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/contracts/route"
+	"github.com/goravel/framework/facades"
+	"github.com/goravel/framework/foundation"
+	"github.com/goravel/framework/http/middleware"
+	frameworkroute "github.com/goravel/framework/route"
+)
+
+var anyMethods = []string{
+	http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodHead,
+	http.MethodOptions, http.MethodDelete, http.MethodConnect, http.MethodTrace,
+}
+
+func init() {
+	frameworkroute.RegisterDriver("gin", NewRoute)
+}
+
+// Route The route.Engine of the gin driver, it's registered as the gin driver when the package is imported:
+// import _ "github.com/goravel/framework/route/gin"
+type Route struct {
+	route.Route
+	instance          *gin.Engine
+	routes            *frameworkroute.Routes
+	globalMiddlewares []httpcontract.Middleware
+	server            frameworkroute.Server
+}
+
+func NewRoute() route.Engine {
+	gin.SetMode(gin.ReleaseMode)
+	engine := gin.New()
+	engine.HandleMethodNotAllowed = true
+	engine.Use(middlewareToGinHandler(middleware.Recovery()))
+	if debugLog := getDebugLog(); debugLog != nil {
+		engine.Use(debugLog)
+	}
+
+	routes := frameworkroute.NewRoutes()
+
+	r := &Route{instance: engine, routes: routes, Route: newGroup(
+		engine.Group("/"),
+		"",
+		[]httpcontract.Middleware{},
+		routes,
+	)}
+	routes.OnCreateDomain(func(item *frameworkroute.Domain) {
+		item.SetHandler(r.newDomainEngine())
+	})
+	r.Fallback(frameworkroute.NotFound)
+	r.MethodNotAllowed(frameworkroute.MethodNotAllowed)
+
+	return r
+}
+
+func (r *Route) Run(addr string) error {
+	r.printRoutes()
+	color.Greenln("Listening and serving HTTP on " + addr)
+
+	return r.server.Run(r, addr, "", "")
+}
+
+func (r *Route) RunTLS(addr, certFile, keyFile string) error {
+	r.printRoutes()
+	color.Greenln("Listening and serving HTTPS on " + addr)
+
+	return r.server.Run(r, addr, certFile, keyFile)
+}
+
+func (r *Route) Shutdown(ctx context.Context) error {
+	return r.server.Shutdown(ctx)
+}
+
+func (r *Route) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if item, params := r.routes.FindDomain(req); item != nil {
+		item.Handler().ServeHTTP(w, frameworkroute.WithHostParams(req, params))
+
+		return
+	}
+
+	r.instance.ServeHTTP(w, req)
+}
+
+func (r *Route) GlobalMiddleware(handlers ...httpcontract.Middleware) {
+	r.instance.Use(middlewaresToGinHandlers(handlers)...)
+	for _, item := range r.routes.Domains() {
+		item.Handler().(*gin.Engine).Use(middlewaresToGinHandlers(handlers)...)
+	}
+	r.globalMiddlewares = append(r.globalMiddlewares, handlers...)
+	r.routes.AddGlobalMiddlewares(handlers)
+	r.Route = newGroup(
+		r.instance.Group("/"),
+		"",
+		[]httpcontract.Middleware{},
+		r.routes,
+	)
+}
+
+func (r *Route) Fallback(handler httpcontract.HandlerFunc) {
+	r.instance.NoRoute(handlerToGinHandler(handler))
+}
+
+func (r *Route) MethodNotAllowed(handler httpcontract.HandlerFunc) {
+	r.instance.NoMethod(func(ginCtx *gin.Context) {
+		var methods []string
+		for _, item := range r.instance.Routes() {
+			if frameworkroute.PathMatches(item.Path, ginCtx.Request.URL.Path) && !contains(methods, item.Method) {
+				methods = append(methods, item.Method)
+			}
+		}
+		sort.Strings(methods)
+		ginCtx.Header("Allow", strings.Join(methods, ", "))
+
+		handler(NewContext(ginCtx))
+	})
+}
+
+func (r *Route) Routes() []route.Info {
+	return r.routes.All()
+}
+
+// newDomainEngine Create the engine serving the routes of a domain, the parameters of the host are appended to the path parameters.
+func (r *Route) newDomainEngine() *gin.Engine {
+	engine := gin.New()
+	engine.Use(middlewareToGinHandler(middleware.Recovery()), func(ginCtx *gin.Context) {
+		for key, value := range frameworkroute.HostParams(ginCtx.Request) {
+			ginCtx.Params = append(ginCtx.Params, gin.Param{Key: key, Value: value})
+		}
+	})
+	if debugLog := getDebugLog(); debugLog != nil {
+		engine.Use(debugLog)
+	}
+	engine.Use(middlewaresToGinHandlers(r.globalMiddlewares)...)
+
+	return engine
+}
+
+func (r *Route) printRoutes() {
+	rootApp := foundation.Application{}
+	if facades.Config.GetBool("app.debug") && !rootApp.RunningInConsole() {
+		r.routes.Print()
+	}
+}
+
+// Group The route.Route of the gin driver.
+type Group struct {
+	instance          gin.IRouter
+	routes            *frameworkroute.Routes
+	originPrefix      string
+	originMiddlewares []httpcontract.Middleware
+	originDomain      *frameworkroute.Domain
+	prefix            string
+	middlewares       []httpcontract.Middleware
+	domain            string
+}
+
+func newGroup(instance gin.IRouter, prefix string, originMiddlewares []httpcontract.Middleware, routes *frameworkroute.Routes) route.Route {
+	return &Group{
+		instance:          instance,
+		routes:            routes,
+		originPrefix:      prefix,
+		originMiddlewares: originMiddlewares,
+	}
+}
+
+func (r *Group) Group(handler route.GroupFunc) {
+	var middlewares []httpcontract.Middleware
+	middlewares = append(middlewares, r.originMiddlewares...)
+	middlewares = append(middlewares, r.middlewares...)
+	r.middlewares = []httpcontract.Middleware{}
+	prefix := frameworkroute.NormalizePath(r.originPrefix + "/" + r.prefix)
+	r.prefix = ""
+
+	group := newGroup(r.instance, prefix, middlewares, r.routes).(*Group)
+	group.originDomain = r.takeDomain()
+
+	handler(group)
+}
+
+func (r *Group) Prefix(addr string) route.Route {
+	r.prefix += "/" + addr
+
+	return r
+}
+
+func (r *Group) Domain(domain string) route.Route {
+	r.domain = domain
+
+	return r
+}
+
+func (r *Group) Middleware(handlers ...httpcontract.Middleware) route.Route {
+	r.middlewares = append(r.middlewares, handlers...)
+
+	return r
+}
+
+func (r *Group) Any(relativePath string, handler httpcontract.HandlerFunc) route.Action {
+	return r.handle(anyMethods, relativePath, handler)
+}
+
+func (r *Group) Get(relativePath string, handler httpcontract.HandlerFunc) route.Action {
+	return r.handle([]string{http.MethodGet}, relativePath, handler)
+}
+
+func (r *Group) Post(relativePath string, handler httpcontract.HandlerFunc) route.Action {
+	return r.handle([]string{http.MethodPost}, relativePath, handler)
+}
+
+func (r *Group) Delete(relativePath string, handler httpcontract.HandlerFunc) route.Action {
+	return r.handle([]string{http.MethodDelete}, relativePath, handler)
+}
+
+func (r *Group) Patch(relativePath string, handler httpcontract.HandlerFunc) route.Action {
+	return r.handle([]string{http.MethodPatch}, relativePath, handler)
+}
+
+func (r *Group) Put(relativePath string, handler httpcontract.HandlerFunc) route.Action {
+	return r.handle([]string{http.MethodPut}, relativePath, handler)
+}
+
+func (r *Group) Options(relativePath string, handler httpcontract.HandlerFunc) route.Action {
+	return r.handle([]string{http.MethodOptions}, relativePath, handler)
+}
+
+func (r *Group) WebSocket(relativePath string, handler httpcontract.WebSocketHandler) route.Action {
+	return frameworkroute.WebSocket(r, relativePath, handler)
+}
+
+func (r *Group) Resource(path string, controller route.ResourceController) {
+	frameworkroute.Resource(r, path, controller, controller)
+}
+
+func (r *Group) ApiResource(path string, controller route.ApiResourceController) {
+	frameworkroute.Resource(r, path, controller, nil)
+}
+
+func (r *Group) Static(relativePath, root string) {
+	r.getGinRoutesWithMiddlewares(r.static(strings.TrimSuffix(relativePath, "/")+"/*filepath")).Static(frameworkroute.NormalizePath(relativePath), root)
+}
+
+func (r *Group) StaticFile(relativePath, filepath string) {
+	r.getGinRoutesWithMiddlewares(r.static(relativePath)).StaticFile(frameworkroute.NormalizePath(relativePath), filepath)
+}
+
+func (r *Group) StaticFS(relativePath string, fs http.FileSystem) {
+	r.getGinRoutesWithMiddlewares(r.static(strings.TrimSuffix(relativePath, "/")+"/*filepath")).StaticFS(frameworkroute.NormalizePath(relativePath), fs)
+}
+
+func (r *Group) Url(name string, params map[string]interface{}) (string, error) {
+	return r.routes.Url(name, params)
+}
+
+func (r *Group) Signed(name string, params map[string]interface{}) (string, error) {
+	return r.routes.Signed(name, time.Time{}, params)
+}
+
+func (r *Group) TemporarySigned(name string, expiration time.Time, params map[string]interface{}) (string, error) {
+	return r.routes.Signed(name, expiration, params)
+}
+
+func (r *Group) handle(methods []string, relativePath string, handler httpcontract.HandlerFunc) route.Action {
+	fullPath := frameworkroute.NormalizePath(r.originPrefix + "/" + r.prefix + "/" + relativePath)
+	if len(fullPath) > 1 && !strings.HasSuffix(relativePath, "/") {
+		fullPath = strings.TrimSuffix(fullPath, "/")
+	}
+	var middlewares []httpcontract.Middleware
+	middlewares = append(middlewares, r.originMiddlewares...)
+	middlewares = append(middlewares, r.middlewares...)
+	instance, pattern := r.instance, ""
+	if item := r.takeDomain(); item != nil {
+		instance, pattern = item.Handler().(*gin.Engine), item.Pattern()
+		for _, method := range methods {
+			item.Add(method, fullPath)
+		}
+	}
+	ginRoutes := r.getGinRoutesWithMiddlewares(instance)
+	for _, method := range methods {
+		ginRoutes.Handle(method, frameworkroute.NormalizePath(relativePath), handlerToGinHandler(handler))
+	}
+
+	return r.routes.Add(methods, pattern, fullPath, handler, middlewares)
+}
+
+// static Get the router of the static routes, the routes are indexed by the domain if the group has one.
+func (r *Group) static(relativePath string) gin.IRouter {
+	item := r.takeDomain()
+	if item == nil {
+		return r.instance
+	}
+
+	fullPath := frameworkroute.NormalizePath(r.originPrefix + "/" + r.prefix + "/" + relativePath)
+	item.Add(http.MethodGet, fullPath)
+	item.Add(http.MethodHead, fullPath)
+
+	return item.Handler().(*gin.Engine)
+}
+
+// takeDomain Get the domain of the route and reset the domain of the group.
+func (r *Group) takeDomain() *frameworkroute.Domain {
+	if r.domain == "" {
+		return r.originDomain
+	}
+
+	item := r.routes.Domain(r.domain)
+	r.domain = ""
+
+	return item
+}
+
+func (r *Group) getGinRoutesWithMiddlewares(instance gin.IRouter) gin.IRoutes {
+	prefix := frameworkroute.NormalizePath(r.originPrefix + "/" + r.prefix)
+	if len(prefix) > 1 {
+		prefix = strings.TrimSuffix(prefix, "/")
+	}
+	r.prefix = ""
+	ginGroup := instance.Group(prefix)
+
+	var middlewares []gin.HandlerFunc
+	ginOriginMiddlewares := middlewaresToGinHandlers(r.originMiddlewares)
+	ginMiddlewares := middlewaresToGinHandlers(r.middlewares)
+	middlewares = append(middlewares, ginOriginMiddlewares...)
+	middlewares = append(middlewares, ginMiddlewares...)
+	r.middlewares = []httpcontract.Middleware{}
+	if len(middlewares) > 0 {
+		return ginGroup.Use(middlewares...)
+	} else {
+		return ginGroup
+	}
+}
+
+func middlewaresToGinHandlers(middlewares []httpcontract.Middleware) []gin.HandlerFunc {
+	var ginHandlers []gin.HandlerFunc
+	for _, item := range middlewares {
+		ginHandlers = append(ginHandlers, middlewareToGinHandler(item))
+	}
+
+	return ginHandlers
+}
+
+func handlerToGinHandler(handler httpcontract.HandlerFunc) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		handler(NewContext(ginCtx))
+	}
+}
+
+func middlewareToGinHandler(handler httpcontract.Middleware) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		handler(NewContext(ginCtx))
+	}
+}
+
+func getDebugLog() gin.HandlerFunc {
+	logFormatter := func(param gin.LogFormatterParams) string {
+		var statusColor, methodColor, resetColor string
+		if param.IsOutputColor() {
+			statusColor = param.StatusCodeColor()
+			methodColor = param.MethodColor()
+			resetColor = param.ResetColor()
+		}
+
+		if param.Latency > time.Minute {
+			// Truncate in a golang < 1.8 safe way
+			param.Latency = param.Latency - param.Latency%time.Second
+		}
+		return fmt.Sprintf("[HTTP] %v |%s %3d %s| %13v | %15s |%s %-7s %s %#v\n%s",
+			param.TimeStamp.Format("2006/01/02 - 15:04:05"),
+			statusColor, param.StatusCode, resetColor,
+			param.Latency,
+			param.ClientIP,
+			methodColor, param.Method, resetColor,
+			param.Path,
+			param.ErrorMessage,
+		)
+	}
+
+	if facades.Config.GetBool("app.debug") {
+		return gin.LoggerWithFormatter(logFormatter)
+	}
+
+	return nil
+}
+
+func contains(items []string, value string) bool {
+	for _, item := range items {
+		if item == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,140 @@
+package gin
+
+import (
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/contracts/route"
+	frameworkroute "github.com/goravel/framework/route"
+	"github.com/goravel/framework/testing/mock"
+)
+
+func TestRegister(t *testing.T) {
+	mockConfig := mock.Config()
+	mockConfig.On("GetString", "http.driver").Return("").Once()
+	mockConfig.On("GetBool", "app.debug").Return(false).Once()
+
+	app := frameworkroute.Application{}
+	engine, err := app.Init()
+	assert.Nil(t, err)
+	assert.IsType(t, &Route{}, engine)
+
+	mockConfig.AssertExpectations(t)
+}
+
+type PhotoController struct {
+}
+
+func (c *PhotoController) Index(ctx http.Context) {
+	ctx.Response().String(nethttp.StatusOK, "index")
+}
+
+func (c *PhotoController) Create(ctx http.Context) {
+	ctx.Response().String(nethttp.StatusOK, "create")
+}
+
+func (c *PhotoController) Store(ctx http.Context) {
+	ctx.Response().String(nethttp.StatusCreated, "store")
+}
+
+func (c *PhotoController) Show(ctx http.Context) {
+	ctx.Response().String(nethttp.StatusOK, "show "+ctx.Request().Input("id"))
+}
+
+func (c *PhotoController) Edit(ctx http.Context) {
+	ctx.Response().String(nethttp.StatusOK, "edit "+ctx.Request().Input("id"))
+}
+
+func (c *PhotoController) Update(ctx http.Context) {
+	ctx.Response().String(nethttp.StatusOK, "update "+ctx.Request().Input("id"))
+}
+
+func (c *PhotoController) Destroy(ctx http.Context) {
+	ctx.Response().String(nethttp.StatusOK, "destroy "+ctx.Request().Input("id"))
+}
+
+func TestResource(t *testing.T) {
+	mockConfig := mock.Config()
+	mockConfig.On("GetBool", "app.debug").Return(false)
+
+	engine := NewRoute()
+	engine.Prefix("admin").Middleware(func(ctx http.Context) {
+		ctx.Response().Header("X-Admin", "1")
+		ctx.Request().Next()
+	}).Resource("photos", &PhotoController{})
+	engine.ApiResource("/api/photos/", &PhotoController{})
+
+	tests := []struct {
+		method       string
+		url          string
+		expectCode   int
+		expectBody   string
+		expectHeader string
+	}{
+		{method: "GET", url: "/admin/photos", expectCode: 200, expectBody: "index", expectHeader: "1"},
+		{method: "GET", url: "/admin/photos/create", expectCode: 200, expectBody: "create", expectHeader: "1"},
+		{method: "POST", url: "/admin/photos", expectCode: 201, expectBody: "store", expectHeader: "1"},
+		{method: "GET", url: "/admin/photos/1", expectCode: 200, expectBody: "show 1", expectHeader: "1"},
+		{method: "GET", url: "/admin/photos/1/edit", expectCode: 200, expectBody: "edit 1", expectHeader: "1"},
+		{method: "PUT", url: "/admin/photos/1", expectCode: 200, expectBody: "update 1", expectHeader: "1"},
+		{method: "PATCH", url: "/admin/photos/1", expectCode: 200, expectBody: "update 1", expectHeader: "1"},
+		{method: "DELETE", url: "/admin/photos/1", expectCode: 200, expectBody: "destroy 1", expectHeader: "1"},
+		{method: "GET", url: "/api/photos", expectCode: 200, expectBody: "index"},
+		{method: "GET", url: "/api/photos/1", expectCode: 200, expectBody: "show 1"},
+		{method: "GET", url: "/api/photos/1/edit", expectCode: 404, expectBody: `{"message":"Not Found"}`},
+	}
+
+	for _, test := range tests {
+		w := httptest.NewRecorder()
+		req, _ := nethttp.NewRequest(test.method, test.url, nil)
+		req.Header.Set("Accept", "application/json")
+		engine.ServeHTTP(w, req)
+		assert.Equal(t, test.expectCode, w.Code, test.url)
+		assert.Equal(t, test.expectBody, w.Body.String(), test.url)
+		assert.Equal(t, test.expectHeader, w.Header().Get("X-Admin"), test.url)
+	}
+
+	url, err := engine.Url("api.photos.show", map[string]interface{}{"id": 1})
+	assert.Nil(t, err)
+	assert.Equal(t, "/api/photos/1", url)
+
+	mockConfig.AssertExpectations(t)
+}
+
+func TestRoutes(t *testing.T) {
+	mockConfig := mock.Config()
+	mockConfig.On("GetBool", "app.debug").Return(false).Once()
+
+	engine := NewRoute()
+	engine.GlobalMiddleware(testMiddleware())
+	engine.Prefix("api").Middleware(testMiddleware()).Get("users/{id}", (&PhotoController{}).Show).Name("users.show")
+	engine.Post("users", func(ctx http.Context) {})
+
+	assert.Equal(t, []route.Info{
+		{
+			Method:      "GET",
+			Path:        "/api/users/{id}",
+			Name:        "users.show",
+			Handler:     "gin.(*PhotoController).Show",
+			Middlewares: []string{"gin.testMiddleware", "gin.testMiddleware"},
+		},
+		{
+			Method:      "POST",
+			Path:        "/users",
+			Handler:     "gin.TestRoutes",
+			Middlewares: []string{"gin.testMiddleware"},
+		},
+	}, engine.Routes())
+
+	mockConfig.AssertExpectations(t)
+}
+
+func testMiddleware() http.Middleware {
+	return func(ctx http.Context) {
+		ctx.Request().Next()
+	}
+}
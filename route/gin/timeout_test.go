@@ -0,0 +1,87 @@
+package gin
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/http/middleware"
+	testingmock "github.com/goravel/framework/testing/mock"
+)
+
+func TestTimeout(t *testing.T) {
+	mockConfig := testingmock.Config()
+	mockConfig.On("GetBool", "app.debug").Return(false)
+
+	var wg sync.WaitGroup
+	tests := []struct {
+		name       string
+		handler    contractshttp.HandlerFunc
+		expectCode int
+		expectBody string
+	}{
+		{
+			name: "handled within the timeout",
+			handler: func(ctx contractshttp.Context) {
+				ctx.Response().String(http.StatusOK, "goravel")
+			},
+			expectCode: http.StatusOK,
+			expectBody: "goravel",
+		},
+		{
+			name: "the context is canceled after the timeout",
+			handler: func(ctx contractshttp.Context) {
+				select {
+				case <-ctx.Done():
+				case <-time.After(time.Second):
+					ctx.Response().String(http.StatusOK, "goravel")
+				}
+			},
+			expectCode: http.StatusServiceUnavailable,
+			expectBody: `{"message":"Service Unavailable"}`,
+		},
+		{
+			name: "the response written after the timeout is kept",
+			handler: func(ctx contractshttp.Context) {
+				<-ctx.Done()
+				ctx.Response().String(http.StatusGatewayTimeout, ctx.Err().Error())
+			},
+			expectCode: http.StatusGatewayTimeout,
+			expectBody: "context deadline exceeded",
+		},
+		{
+			name: "the request is read by a goroutine after the handlers",
+			handler: func(ctx contractshttp.Context) {
+				request := ctx.Request().Origin()
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					_ = request.Context()
+				}()
+				ctx.Response().String(http.StatusOK, "goravel")
+			},
+			expectCode: http.StatusOK,
+			expectBody: "goravel",
+		},
+	}
+
+	for _, test := range tests {
+		engine := NewRoute()
+		engine.Middleware(middleware.Timeout(10*time.Millisecond)).Get("/", test.handler)
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Accept", "application/json")
+		engine.ServeHTTP(w, req)
+		wg.Wait()
+
+		assert.Equal(t, test.expectCode, w.Code, test.name)
+		assert.Equal(t, test.expectBody, w.Body.String(), test.name)
+		assert.Nil(t, req.Context().Err(), test.name)
+	}
+}
@@ -0,0 +1,325 @@
+package route
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gookit/color"
+
+	httpcontract "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/contracts/route"
+	"github.com/goravel/framework/facades"
+	"github.com/goravel/framework/foundation"
+	frameworkhttp "github.com/goravel/framework/http"
+	"github.com/goravel/framework/http/middleware"
+)
+
+var anyMethods = []string{
+	http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodHead,
+	http.MethodOptions, http.MethodDelete, http.MethodConnect, http.MethodTrace,
+}
+
+// NetHttp The route.Engine of the net/http driver, it depends on the standard library only.
+type NetHttp struct {
+	route.Route
+	tree              *tree
+	routes            *Routes
+	globalMiddlewares []httpcontract.Middleware
+	notFound          httpcontract.HandlerFunc
+	methodNotAllowed  httpcontract.HandlerFunc
+	server            Server
+}
+
+func NewNetHttp() route.Engine {
+	engine := &NetHttp{tree: newTree(), routes: NewRoutes(), notFound: NotFound, methodNotAllowed: MethodNotAllowed}
+	engine.Route = NewNetHttpGroup(engine, "", []httpcontract.Middleware{})
+
+	return engine
+}
+
+func (r *NetHttp) Run(addr string) error {
+	r.printRoutes()
+	color.Greenln("Listening and serving HTTP on " + addr)
+
+	return r.server.Run(r, addr, "", "")
+}
+
+func (r *NetHttp) RunTLS(addr, certFile, keyFile string) error {
+	r.printRoutes()
+	color.Greenln("Listening and serving HTTPS on " + addr)
+
+	return r.server.Run(r, addr, certFile, keyFile)
+}
+
+func (r *NetHttp) Shutdown(ctx context.Context) error {
+	return r.server.Shutdown(ctx)
+}
+
+func (r *NetHttp) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	handlers, params, found := r.tree.find(req.Method, req.URL.Path)
+	if item, host := r.routes.FindDomain(req); item != nil {
+		handlers, params, found = item.tree.find(req.Method, req.URL.Path)
+		params = mergeParams(host, params)
+	}
+	if !found {
+		handler := r.notFound
+		if methods := r.routes.domains.allowed(req, r.tree); len(methods) > 0 {
+			handler = func(ctx httpcontract.Context) {
+				ctx.Response().Header("Allow", strings.Join(methods, ", "))
+				r.methodNotAllowed(ctx)
+			}
+		}
+		handlers = append(middlewaresToHandlers(r.globalMiddlewares), handler)
+	}
+
+	// The recovery is the first handler, so the panics of the global middlewares are recovered as well.
+	handlers = append([]httpcontract.HandlerFunc{httpcontract.HandlerFunc(middleware.Recovery())}, handlers...)
+
+	frameworkhttp.NewNetHttpContext(w, req, params, handlers).Next()
+}
+
+func (r *NetHttp) GlobalMiddleware(handlers ...httpcontract.Middleware) {
+	r.globalMiddlewares = append(r.globalMiddlewares, handlers...)
+	r.routes.AddGlobalMiddlewares(handlers)
+	r.Route = NewNetHttpGroup(r, "", []httpcontract.Middleware{})
+}
+
+func (r *NetHttp) Fallback(handler httpcontract.HandlerFunc) {
+	r.notFound = handler
+}
+
+func (r *NetHttp) MethodNotAllowed(handler httpcontract.HandlerFunc) {
+	r.methodNotAllowed = handler
+}
+
+func (r *NetHttp) Routes() []route.Info {
+	return r.routes.All()
+}
+
+func (r *NetHttp) printRoutes() {
+	rootApp := foundation.Application{}
+	if facades.Config.GetBool("app.debug") && !rootApp.RunningInConsole() {
+		r.routes.Print()
+	}
+}
+
+type NetHttpGroup struct {
+	engine            *NetHttp
+	originPrefix      string
+	originMiddlewares []httpcontract.Middleware
+	originDomain      *Domain
+	prefix            string
+	middlewares       []httpcontract.Middleware
+	domain            string
+}
+
+func NewNetHttpGroup(engine *NetHttp, prefix string, originMiddlewares []httpcontract.Middleware) route.Route {
+	return &NetHttpGroup{
+		engine:            engine,
+		originPrefix:      prefix,
+		originMiddlewares: originMiddlewares,
+	}
+}
+
+func (r *NetHttpGroup) Group(handler route.GroupFunc) {
+	var middlewares []httpcontract.Middleware
+	middlewares = append(middlewares, r.originMiddlewares...)
+	middlewares = append(middlewares, r.middlewares...)
+	r.middlewares = []httpcontract.Middleware{}
+	prefix := NormalizePath(r.originPrefix + "/" + r.prefix)
+	r.prefix = ""
+
+	group := NewNetHttpGroup(r.engine, prefix, middlewares).(*NetHttpGroup)
+	group.originDomain = r.takeDomain()
+
+	handler(group)
+}
+
+func (r *NetHttpGroup) Prefix(addr string) route.Route {
+	r.prefix += "/" + addr
+
+	return r
+}
+
+func (r *NetHttpGroup) Domain(domain string) route.Route {
+	r.domain = domain
+
+	return r
+}
+
+func (r *NetHttpGroup) Middleware(handlers ...httpcontract.Middleware) route.Route {
+	r.middlewares = append(r.middlewares, handlers...)
+
+	return r
+}
+
+func (r *NetHttpGroup) Any(relativePath string, handler httpcontract.HandlerFunc) route.Action {
+	return r.handle(anyMethods, relativePath, handler)
+}
+
+func (r *NetHttpGroup) Get(relativePath string, handler httpcontract.HandlerFunc) route.Action {
+	return r.handle([]string{http.MethodGet}, relativePath, handler)
+}
+
+func (r *NetHttpGroup) Post(relativePath string, handler httpcontract.HandlerFunc) route.Action {
+	return r.handle([]string{http.MethodPost}, relativePath, handler)
+}
+
+func (r *NetHttpGroup) Delete(relativePath string, handler httpcontract.HandlerFunc) route.Action {
+	return r.handle([]string{http.MethodDelete}, relativePath, handler)
+}
+
+func (r *NetHttpGroup) Patch(relativePath string, handler httpcontract.HandlerFunc) route.Action {
+	return r.handle([]string{http.MethodPatch}, relativePath, handler)
+}
+
+func (r *NetHttpGroup) Put(relativePath string, handler httpcontract.HandlerFunc) route.Action {
+	return r.handle([]string{http.MethodPut}, relativePath, handler)
+}
+
+func (r *NetHttpGroup) Options(relativePath string, handler httpcontract.HandlerFunc) route.Action {
+	return r.handle([]string{http.MethodOptions}, relativePath, handler)
+}
+
+func (r *NetHttpGroup) WebSocket(relativePath string, handler httpcontract.WebSocketHandler) route.Action {
+	return WebSocket(r, relativePath, handler)
+}
+
+func (r *NetHttpGroup) Resource(path string, controller route.ResourceController) {
+	Resource(r, path, controller, controller)
+}
+
+func (r *NetHttpGroup) ApiResource(path string, controller route.ApiResourceController) {
+	Resource(r, path, controller, nil)
+}
+
+func (r *NetHttpGroup) Static(relativePath, root string) {
+	r.StaticFS(relativePath, onlyFilesFS{http.Dir(root)})
+}
+
+func (r *NetHttpGroup) StaticFile(relativePath, filepath string) {
+	r.static(relativePath, func(ctx httpcontract.Context) {
+		http.ServeFile(ctx.Response().Writer(), ctx.Request().Origin(), filepath)
+	})
+}
+
+func (r *NetHttpGroup) StaticFS(relativePath string, fs http.FileSystem) {
+	prefix := r.fullPath(relativePath)
+	fileServer := http.StripPrefix(strings.TrimSuffix(prefix, "/"), http.FileServer(fs))
+
+	r.static(strings.TrimSuffix(relativePath, "/")+"/*filepath", func(ctx httpcontract.Context) {
+		fileServer.ServeHTTP(ctx.Response().Writer(), ctx.Request().Origin())
+	})
+}
+
+func (r *NetHttpGroup) Url(name string, params map[string]interface{}) (string, error) {
+	return r.engine.routes.Url(name, params)
+}
+
+func (r *NetHttpGroup) Signed(name string, params map[string]interface{}) (string, error) {
+	return r.engine.routes.Signed(name, time.Time{}, params)
+}
+
+func (r *NetHttpGroup) TemporarySigned(name string, expiration time.Time, params map[string]interface{}) (string, error) {
+	return r.engine.routes.Signed(name, expiration, params)
+}
+
+func (r *NetHttpGroup) handle(methods []string, relativePath string, handler httpcontract.HandlerFunc) route.Action {
+	fullPath := r.fullPath(relativePath)
+	middlewares := r.takeMiddlewares()
+	tree, pattern := r.engine.tree, ""
+	if item := r.takeDomain(); item != nil {
+		tree, pattern = item.tree, item.pattern
+	}
+	for _, method := range methods {
+		tree.add(method, fullPath, r.handlers(middlewares, handler))
+	}
+
+	return r.engine.routes.Add(methods, pattern, fullPath, handler, middlewares)
+}
+
+// static Register GET and HEAD routes without adding them to the route table, like the gin driver.
+func (r *NetHttpGroup) static(relativePath string, handler httpcontract.HandlerFunc) {
+	fullPath := r.fullPath(relativePath)
+	handlers := r.handlers(r.takeMiddlewares(), handler)
+	tree := r.engine.tree
+	if item := r.takeDomain(); item != nil {
+		tree = item.tree
+	}
+	tree.add(http.MethodGet, fullPath, handlers)
+	tree.add(http.MethodHead, fullPath, handlers)
+}
+
+func (r *NetHttpGroup) fullPath(relativePath string) string {
+	fullPath := NormalizePath(r.originPrefix + "/" + r.prefix + "/" + relativePath)
+	if len(fullPath) > 1 && !strings.HasSuffix(relativePath, "/") {
+		fullPath = strings.TrimSuffix(fullPath, "/")
+	}
+
+	return fullPath
+}
+
+// takeMiddlewares Get the middlewares of the route and reset the prefix and middlewares of the group.
+func (r *NetHttpGroup) takeMiddlewares() []httpcontract.Middleware {
+	var middlewares []httpcontract.Middleware
+	middlewares = append(middlewares, r.originMiddlewares...)
+	middlewares = append(middlewares, r.middlewares...)
+	r.middlewares = []httpcontract.Middleware{}
+	r.prefix = ""
+
+	return middlewares
+}
+
+// takeDomain Get the domain of the route and reset the domain of the group.
+func (r *NetHttpGroup) takeDomain() *Domain {
+	if r.domain == "" {
+		return r.originDomain
+	}
+
+	item := r.engine.routes.Domain(r.domain)
+	r.domain = ""
+
+	return item
+}
+
+func (r *NetHttpGroup) handlers(middlewares []httpcontract.Middleware, handler httpcontract.HandlerFunc) []httpcontract.HandlerFunc {
+	var handlers []httpcontract.HandlerFunc
+	handlers = append(handlers, middlewaresToHandlers(r.engine.globalMiddlewares)...)
+	handlers = append(handlers, middlewaresToHandlers(middlewares)...)
+
+	return append(handlers, handler)
+}
+
+func middlewaresToHandlers(middlewares []httpcontract.Middleware) []httpcontract.HandlerFunc {
+	var handlers []httpcontract.HandlerFunc
+	for _, item := range middlewares {
+		handlers = append(handlers, httpcontract.HandlerFunc(item))
+	}
+
+	return handlers
+}
+
+// onlyFilesFS Disable the directory listing of http.FileServer.
+type onlyFilesFS struct {
+	fs http.FileSystem
+}
+
+func (fs onlyFilesFS) Open(name string) (http.File, error) {
+	file, err := fs.fs.Open(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return neuteredReaddirFile{file}, nil
+}
+
+type neuteredReaddirFile struct {
+	http.File
+}
+
+func (f neuteredReaddirFile) Readdir(count int) ([]os.FileInfo, error) {
+	return nil, nil
+}
@@ -0,0 +1,161 @@
+package route
+
+import (
+	nethttp "net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/contracts/route"
+	"github.com/goravel/framework/testing/mock"
+)
+
+func TestNetHttpResource(t *testing.T) {
+	mockConfig := mock.Config()
+	mockConfig.On("GetBool", "app.debug").Return(false)
+
+	engine := NewNetHttp()
+	engine.Prefix("admin").Middleware(func(ctx http.Context) {
+		ctx.Response().Header("X-Admin", "1")
+		ctx.Request().Next()
+	}).Resource("photos", &PhotoController{})
+	engine.ApiResource("/api/photos/", &PhotoController{})
+
+	tests := []struct {
+		method       string
+		url          string
+		expectCode   int
+		expectBody   string
+		expectHeader string
+	}{
+		{method: "GET", url: "/admin/photos", expectCode: 200, expectBody: "index", expectHeader: "1"},
+		{method: "GET", url: "/admin/photos/create", expectCode: 200, expectBody: "create", expectHeader: "1"},
+		{method: "POST", url: "/admin/photos", expectCode: 201, expectBody: "store", expectHeader: "1"},
+		{method: "GET", url: "/admin/photos/1", expectCode: 200, expectBody: "show 1", expectHeader: "1"},
+		{method: "GET", url: "/admin/photos/1/edit", expectCode: 200, expectBody: "edit 1", expectHeader: "1"},
+		{method: "PUT", url: "/admin/photos/1", expectCode: 200, expectBody: "update 1", expectHeader: "1"},
+		{method: "PATCH", url: "/admin/photos/1", expectCode: 200, expectBody: "update 1", expectHeader: "1"},
+		{method: "DELETE", url: "/admin/photos/1", expectCode: 200, expectBody: "destroy 1", expectHeader: "1"},
+		{method: "GET", url: "/api/photos", expectCode: 200, expectBody: "index"},
+		{method: "GET", url: "/api/photos/1", expectCode: 200, expectBody: "show 1"},
+		{method: "GET", url: "/api/photos/1/edit", expectCode: 404, expectBody: `{"message":"Not Found"}`},
+	}
+
+	for _, test := range tests {
+		w := httptest.NewRecorder()
+		req, _ := nethttp.NewRequest(test.method, test.url, nil)
+		req.Header.Set("Accept", "application/json")
+		engine.ServeHTTP(w, req)
+		assert.Equal(t, test.expectCode, w.Code, test.url)
+		assert.Equal(t, test.expectBody, w.Body.String(), test.url)
+		assert.Equal(t, test.expectHeader, w.Header().Get("X-Admin"), test.url)
+	}
+
+	url, err := engine.Url("api.photos.show", map[string]interface{}{"id": 1})
+	assert.Nil(t, err)
+	assert.Equal(t, "/api/photos/1", url)
+}
+
+func TestNetHttpMiddleware(t *testing.T) {
+	mockConfig := mock.Config()
+	mockConfig.On("GetBool", "app.debug").Return(false)
+
+	engine := NewNetHttp()
+	engine.GlobalMiddleware(func(ctx http.Context) {
+		ctx.Response().Header("X-Global", "1")
+		ctx.Request().Next()
+	})
+	engine.Prefix("api").Group(func(r route.Route) {
+		r.Middleware(func(ctx http.Context) {
+			if ctx.Request().Header("Authorization", "") == "" {
+				ctx.Request().AbortWithStatusJson(nethttp.StatusUnauthorized, map[string]string{"message": "unauthorized"})
+
+				return
+			}
+			ctx.Request().Next()
+		}).Get("users/{id}", func(ctx http.Context) {
+			ctx.Response().Success().Json(map[string]string{"id": ctx.Request().Input("id")})
+		})
+	})
+
+	tests := []struct {
+		name          string
+		url           string
+		authorization string
+		expectCode    int
+		expectBody    string
+	}{
+		{name: "pass", url: "/api/users/1", authorization: "token", expectCode: 200, expectBody: `{"id":"1"}`},
+		{name: "abort", url: "/api/users/1", expectCode: 401, expectBody: `{"message":"unauthorized"}`},
+		{name: "not found", url: "/api/users", expectCode: 404, expectBody: `{"message":"Not Found"}`},
+	}
+
+	for _, test := range tests {
+		w := httptest.NewRecorder()
+		req, _ := nethttp.NewRequest("GET", test.url, nil)
+		req.Header.Set("Authorization", test.authorization)
+		req.Header.Set("Accept", "application/json")
+		engine.ServeHTTP(w, req)
+		assert.Equal(t, test.expectCode, w.Code, test.name)
+		assert.Equal(t, test.expectBody, w.Body.String(), test.name)
+		assert.Equal(t, "1", w.Header().Get("X-Global"), test.name)
+	}
+}
+
+func TestNetHttpDomainMethodNotAllowed(t *testing.T) {
+	mockConfig := mock.Config()
+	mockConfig.On("GetBool", "app.debug").Return(false)
+
+	engine := NewNetHttp()
+	engine.Domain("{tenant}.example.com").Group(func(r route.Route) {
+		r.Get("/dashboard", func(ctx http.Context) {})
+		r.Put("/dashboard", func(ctx http.Context) {})
+	})
+	engine.Domain("admin.example.com").Patch("/dashboard", func(ctx http.Context) {})
+	engine.Post("/dashboard", func(ctx http.Context) {})
+
+	tests := []struct {
+		host        string
+		expectAllow string
+	}{
+		{host: "acme.example.com", expectAllow: "GET, POST, PUT"},
+		{host: "admin.example.com", expectAllow: "GET, PATCH, POST, PUT"},
+		{host: "example.com", expectAllow: "POST"},
+	}
+
+	for _, test := range tests {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(nethttp.MethodDelete, "/dashboard", nil)
+		req.Host = test.host
+		engine.ServeHTTP(w, req)
+		assert.Equal(t, nethttp.StatusMethodNotAllowed, w.Code, test.host)
+		assert.Equal(t, test.expectAllow, w.Header().Get("Allow"), test.host)
+	}
+}
+
+func TestNetHttpStatic(t *testing.T) {
+	dir := t.TempDir()
+	assert.Nil(t, os.WriteFile(dir+"/goravel.txt", []byte("goravel"), 0644))
+
+	engine := NewNetHttp()
+	engine.Static("public", dir)
+	engine.StaticFile("favicon.txt", dir+"/goravel.txt")
+
+	for _, url := range []string{"/public/goravel.txt", "/favicon.txt"} {
+		w := httptest.NewRecorder()
+		req, _ := nethttp.NewRequest("GET", url, nil)
+		engine.ServeHTTP(w, req)
+		assert.Equal(t, 200, w.Code, url)
+		assert.Equal(t, "goravel", strings.TrimSpace(w.Body.String()), url)
+	}
+
+	w := httptest.NewRecorder()
+	req, _ := nethttp.NewRequest("GET", "/public/", nil)
+	engine.ServeHTTP(w, req)
+	assert.NotContains(t, w.Body.String(), "goravel.txt")
+	assert.Empty(t, engine.Routes())
+}
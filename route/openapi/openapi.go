@@ -0,0 +1,194 @@
+package openapi
+
+import (
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/contracts/route"
+	"github.com/goravel/framework/facades"
+)
+
+// Generate Build the OpenAPI spec of the routes, the info comes from the openapi.title, openapi.description
+// and openapi.version configs, the servers come from the openapi.servers config.
+// The paths aren't keyed by the domains of the routes, the domains should be listed in the openapi.servers config,
+// and the routes with the same method and path on different domains are documented once, the last one is kept.
+func Generate(routes []route.Info) *Spec {
+	spec := &Spec{
+		OpenApi: Version,
+		Info: Info{
+			Title:       facades.Config.GetString("openapi.title", facades.Config.GetString("app.name", "Goravel")),
+			Description: facades.Config.GetString("openapi.description"),
+			Version:     facades.Config.GetString("openapi.version", "1.0.0"),
+		},
+		Paths: make(map[string]*PathItem),
+	}
+	if servers, ok := facades.Config.Get("openapi.servers", []string{}).([]string); ok {
+		for _, server := range servers {
+			spec.Servers = append(spec.Servers, Server{Url: server})
+		}
+	}
+
+	schemas := newSchemas()
+	operationIDs := make(map[string]bool)
+	for _, info := range routes {
+		path := strings.TrimSuffix(info.Path, "/")
+		if path == "" {
+			path = "/"
+		}
+
+		item, exist := spec.Paths[path]
+		if !exist {
+			item = &PathItem{}
+			spec.Paths[path] = item
+		}
+
+		// The routes registered via Any share the same name, but the operation IDs must be unique.
+		operation := newOperation(schemas, info)
+		if operation.OperationID != "" {
+			if operationIDs[operation.OperationID] {
+				operation.OperationID += "_" + strings.ToLower(info.Method)
+			}
+			operationIDs[operation.OperationID] = true
+		}
+		item.set(info.Method, operation)
+	}
+
+	if len(schemas.components) > 0 {
+		spec.Components = &Components{Schemas: schemas.components}
+	}
+
+	return spec
+}
+
+// Handler Serve the OpenAPI spec of facades.Route.
+func Handler(ctx contractshttp.Context) {
+	ctx.Response().Json(http.StatusOK, Generate(facades.Route.Routes()))
+}
+
+func newOperation(schemas *schemas, info route.Info) *Operation {
+	operation := &Operation{
+		OperationID: info.Name,
+		Parameters:  pathParameters(info.Path),
+		Responses:   make(map[string]*Response),
+	}
+
+	annotation := info.Operation
+	if annotation == nil {
+		operation.Responses[strconv.Itoa(http.StatusOK)] = &Response{Description: http.StatusText(http.StatusOK)}
+
+		return operation
+	}
+
+	operation.Summary = annotation.Summary
+	operation.Description = annotation.Description
+	operation.Tags = annotation.Tags
+	operation.Deprecated = annotation.Deprecated
+
+	if annotation.Request != nil {
+		if hasBody(info.Method) {
+			operation.RequestBody = &RequestBody{
+				Required: true,
+				Content: map[string]*MediaType{
+					"application/json": {Schema: schemas.requestSchema(annotation.Request)},
+				},
+			}
+		} else {
+			operation.Parameters = append(operation.Parameters, queryParameters(schemas, annotation.Request)...)
+		}
+	}
+
+	for code, response := range annotation.Responses {
+		content := &Response{Description: http.StatusText(code)}
+		if response != nil {
+			content.Content = map[string]*MediaType{
+				"application/json": {Schema: schemas.schemaOf(reflect.TypeOf(response))},
+			}
+		}
+		operation.Responses[strconv.Itoa(code)] = content
+	}
+	if len(operation.Responses) == 0 {
+		operation.Responses[strconv.Itoa(http.StatusOK)] = &Response{Description: http.StatusText(http.StatusOK)}
+	}
+
+	return operation
+}
+
+func pathParameters(path string) []Parameter {
+	var parameters []Parameter
+	for _, segment := range strings.Split(path, "/") {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			parameters = append(parameters, Parameter{
+				Name:     strings.TrimSuffix(strings.TrimPrefix(segment, "{"), "}"),
+				In:       "path",
+				Required: true,
+				Schema:   &Schema{Type: "string"},
+			})
+		}
+	}
+
+	return parameters
+}
+
+// queryParameters Get the query parameters from the fields of the request, the name is the form tag, json tag or field name.
+func queryParameters(schemas *schemas, request interface{}) []Parameter {
+	t := reflect.TypeOf(request)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	schema := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+	for _, field := range fields(t, "form") {
+		schema.Properties[field.name] = schemas.schemaOf(field.typ)
+	}
+	schemas.withRules(schema, request)
+
+	names := make([]string, 0, len(schema.Properties))
+	for name := range schema.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	parameters := make([]Parameter, 0, len(names))
+	for _, name := range names {
+		parameters = append(parameters, Parameter{
+			Name:     name,
+			In:       "query",
+			Required: contains(schema.Required, name),
+			Schema:   schema.Properties[name],
+		})
+	}
+
+	return parameters
+}
+
+func hasBody(method string) bool {
+	return method != http.MethodGet && method != http.MethodHead && method != http.MethodDelete
+}
+
+func (p *PathItem) set(method string, operation *Operation) {
+	switch method {
+	case http.MethodGet:
+		p.Get = operation
+	case http.MethodPut:
+		p.Put = operation
+	case http.MethodPost:
+		p.Post = operation
+	case http.MethodDelete:
+		p.Delete = operation
+	case http.MethodOptions:
+		p.Options = operation
+	case http.MethodHead:
+		p.Head = operation
+	case http.MethodPatch:
+		p.Patch = operation
+	case http.MethodTrace:
+		p.Trace = operation
+	}
+}
@@ -0,0 +1,225 @@
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/contracts/route"
+	"github.com/goravel/framework/testing/mock"
+)
+
+type Model struct {
+	ID        uint      `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type User struct {
+	Model
+	Name    string  `json:"name"`
+	Email   string  `json:"email,omitempty"`
+	Friends []*User `json:"friends"`
+	Secret  string  `json:"-"`
+	hidden  string
+}
+
+type StoreUserRequest struct {
+	Name  string   `json:"name"`
+	Email string   `json:"email"`
+	Age   int      `json:"age"`
+	Role  string   `json:"role"`
+	Tags  []string `json:"tags"`
+}
+
+func (r *StoreUserRequest) Authorize() bool {
+	return true
+}
+
+func (r *StoreUserRequest) Rules() map[string]string {
+	return map[string]string{
+		"name":   "required|string|max:255",
+		"email":  "required|email",
+		"age":    "integer|between:18,60",
+		"role":   "in:admin,user",
+		"tags.*": "string|min:2",
+	}
+}
+
+func (r *StoreUserRequest) Messages() map[string]string {
+	return nil
+}
+
+func (r *StoreUserRequest) Attributes() map[string]string {
+	return nil
+}
+
+// UpdateUserRequest The User is shared by the request and the responses.
+type UpdateUserRequest struct {
+	User User `json:"user"`
+}
+
+func (r *UpdateUserRequest) Authorize() bool {
+	return true
+}
+
+func (r *UpdateUserRequest) Rules() map[string]string {
+	return map[string]string{
+		"user.name": "required|max:20",
+	}
+}
+
+func (r *UpdateUserRequest) Messages() map[string]string {
+	return nil
+}
+
+func (r *UpdateUserRequest) Attributes() map[string]string {
+	return nil
+}
+
+type IndexUserRequest struct {
+	Page    int    `form:"page"`
+	Keyword string `form:"keyword"`
+}
+
+func (r *IndexUserRequest) Authorize() bool {
+	return true
+}
+
+func (r *IndexUserRequest) Rules() map[string]string {
+	return map[string]string{
+		"page": "required|min:1",
+	}
+}
+
+func (r *IndexUserRequest) Messages() map[string]string {
+	return nil
+}
+
+func (r *IndexUserRequest) Attributes() map[string]string {
+	return nil
+}
+
+func TestGenerate(t *testing.T) {
+	mockConfig := mock.Config()
+	mockConfig.On("GetString", "app.name", "Goravel").Return("Goravel").Once()
+	mockConfig.On("GetString", "openapi.title", "Goravel").Return("Goravel").Once()
+	mockConfig.On("GetString", "openapi.description").Return("").Once()
+	mockConfig.On("GetString", "openapi.version", "1.0.0").Return("1.0.0").Once()
+	mockConfig.On("Get", "openapi.servers", []string{}).Return([]string{"https://api.goravel.dev"}).Once()
+
+	spec := Generate([]route.Info{
+		{Method: http.MethodGet, Path: "/users", Name: "users.index", Operation: &route.Operation{
+			Tags:      []string{"users"},
+			Request:   &IndexUserRequest{},
+			Responses: map[int]interface{}{http.StatusOK: []User{}},
+		}},
+		{Method: http.MethodPost, Path: "/users", Name: "users.store", Operation: &route.Operation{
+			Summary:    "Create a user",
+			Deprecated: true,
+			Request:    &StoreUserRequest{},
+			Responses:  map[int]interface{}{http.StatusCreated: &User{}, http.StatusNoContent: nil},
+		}},
+		{Method: http.MethodGet, Path: "/users/{id}/"},
+		{Method: http.MethodPut, Path: "/users/{id}", Name: "users.update", Operation: &route.Operation{
+			Request:   &UpdateUserRequest{},
+			Responses: map[int]interface{}{http.StatusOK: &User{}},
+		}},
+		{Method: http.MethodGet, Path: "/any", Name: "any"},
+		{Method: http.MethodPost, Path: "/any", Name: "any"},
+	})
+
+	data, err := json.Marshal(spec)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{
+		"openapi": "3.0.3",
+		"info": {"title": "Goravel", "version": "1.0.0"},
+		"servers": [{"url": "https://api.goravel.dev"}],
+		"paths": {
+			"/users": {
+				"get": {
+					"operationId": "users.index",
+					"tags": ["users"],
+					"parameters": [
+						{"name": "keyword", "in": "query", "schema": {"type": "string"}},
+						{"name": "page", "in": "query", "required": true, "schema": {"type": "integer", "minimum": 1}}
+					],
+					"responses": {
+						"200": {"description": "OK", "content": {"application/json": {"schema": {"type": "array", "items": {"$ref": "#/components/schemas/User"}}}}}
+					}
+				},
+				"post": {
+					"operationId": "users.store",
+					"summary": "Create a user",
+					"deprecated": true,
+					"requestBody": {"required": true, "content": {"application/json": {"schema": {
+						"type": "object",
+						"properties": {
+							"name": {"type": "string", "maxLength": 255},
+							"email": {"type": "string", "format": "email"},
+							"age": {"type": "integer", "minimum": 18, "maximum": 60},
+							"role": {"type": "string", "enum": ["admin", "user"]},
+							"tags": {"type": "array", "items": {"type": "string", "minLength": 2}}
+						},
+						"required": ["email", "name"]
+					}}}},
+					"responses": {
+						"201": {"description": "Created", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/User"}}}},
+						"204": {"description": "No Content"}
+					}
+				}
+			},
+			"/users/{id}": {
+				"get": {
+					"parameters": [{"name": "id", "in": "path", "required": true, "schema": {"type": "string"}}],
+					"responses": {"200": {"description": "OK"}}
+				},
+				"put": {
+					"operationId": "users.update",
+					"parameters": [{"name": "id", "in": "path", "required": true, "schema": {"type": "string"}}],
+					"requestBody": {"required": true, "content": {"application/json": {"schema": {
+						"type": "object",
+						"properties": {
+							"user": {
+								"type": "object",
+								"properties": {
+									"id": {"type": "integer"},
+									"created_at": {"type": "string", "format": "date-time"},
+									"name": {"type": "string", "maxLength": 20},
+									"email": {"type": "string"},
+									"friends": {"type": "array", "items": {"$ref": "#/components/schemas/User"}}
+								},
+								"required": ["name"]
+							}
+						}
+					}}}},
+					"responses": {
+						"200": {"description": "OK", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/User"}}}}
+					}
+				}
+			},
+			"/any": {
+				"get": {"operationId": "any", "responses": {"200": {"description": "OK"}}},
+				"post": {"operationId": "any_post", "responses": {"200": {"description": "OK"}}}
+			}
+		},
+		"components": {
+			"schemas": {
+				"User": {
+					"type": "object",
+					"properties": {
+						"id": {"type": "integer"},
+						"created_at": {"type": "string", "format": "date-time"},
+						"name": {"type": "string"},
+						"email": {"type": "string"},
+						"friends": {"type": "array", "items": {"$ref": "#/components/schemas/User"}}
+					}
+				}
+			}
+		}
+	}`, string(data))
+
+	mockConfig.AssertExpectations(t)
+}
@@ -0,0 +1,368 @@
+package openapi
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	contractshttp "github.com/goravel/framework/contracts/http"
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// schemas Build the schemas of the types, the named structs are registered as components and referenced.
+type schemas struct {
+	components map[string]*Schema
+	names      map[reflect.Type]string
+}
+
+func newSchemas() *schemas {
+	return &schemas{
+		components: make(map[string]*Schema),
+		names:      make(map[reflect.Type]string),
+	}
+}
+
+func (s *schemas) schemaOf(t reflect.Type) *Schema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == timeType {
+		return &Schema{Type: "string", Format: "date-time"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Uint, reflect.Uint8, reflect.Uint16:
+		return &Schema{Type: "integer"}
+	case reflect.Int32, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int64, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+
+		return &Schema{Type: "array", Items: s.schemaOf(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: s.schemaOf(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return s.structSchema(t)
+		}
+
+		return s.ref(t)
+	}
+
+	return &Schema{}
+}
+
+// ref Register the struct as a component, the name is prefixed with the package if it's taken by another type.
+func (s *schemas) ref(t reflect.Type) *Schema {
+	name, exist := s.names[t]
+	if !exist {
+		name = t.Name()
+		if _, taken := s.components[name]; taken {
+			pkg := t.PkgPath()
+			name = pkg[strings.LastIndex(pkg, "/")+1:] + "." + name
+		}
+		name = strings.NewReplacer("[", "_", "]", "", "*", "", "/", ".", ",", "_", " ", "").Replace(name)
+
+		// The placeholder is registered first, so the recursive types don't loop forever.
+		s.names[t] = name
+		s.components[name] = &Schema{}
+		*s.components[name] = *s.structSchema(t)
+	}
+
+	return &Schema{Ref: "#/components/schemas/" + name}
+}
+
+// resolve Get the component of a referenced schema.
+func (s *schemas) resolve(schema *Schema) *Schema {
+	if schema.Ref == "" {
+		return schema
+	}
+
+	return s.components[strings.TrimPrefix(schema.Ref, "#/components/schemas/")]
+}
+
+func (s *schemas) structSchema(t reflect.Type) *Schema {
+	schema := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+	for _, field := range fields(t, "json") {
+		schema.Properties[field.name] = s.schemaOf(field.typ)
+	}
+
+	return schema
+}
+
+// requestSchema The struct of a FormRequest is inlined, so its rules don't change the component shared by the
+// other requests and responses.
+func (s *schemas) requestSchema(request interface{}) *Schema {
+	t := reflect.TypeOf(request)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if _, ok := request.(contractshttp.FormRequest); !ok || t.Kind() != reflect.Struct {
+		return s.schemaOf(t)
+	}
+
+	return s.withRules(s.structSchema(t), request)
+}
+
+// withRules Map the validation rules of a FormRequest into the schema, the keys of the rules are paths: address.city, tags.*
+// The nested schemas are copied before they are changed, the schema itself should be owned by the request.
+func (s *schemas) withRules(schema *Schema, request interface{}) *Schema {
+	formRequest, ok := request.(contractshttp.FormRequest)
+	if !ok {
+		return schema
+	}
+
+	// The keys are sorted, so the required fields are in the same order every time.
+	rules := formRequest.Rules()
+	keys := make([]string, 0, len(rules))
+	for key := range rules {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		parent := schema
+		segments := strings.Split(key, ".")
+		for i, segment := range segments {
+			if segment == "*" {
+				if parent.Items == nil {
+					parent.Type, parent.Items = "array", &Schema{}
+				}
+				parent.Items = s.copy(parent.Items)
+				if i == len(segments)-1 {
+					applyRules(parent.Items, rules[key])
+				}
+				parent = parent.Items
+
+				continue
+			}
+
+			if parent.Properties == nil {
+				parent.Type, parent.Properties = "object", make(map[string]*Schema)
+			}
+			property, exist := parent.Properties[segment]
+			if !exist {
+				property = &Schema{}
+			}
+			property = s.copy(property)
+			parent.Properties[segment] = property
+			if i == len(segments)-1 {
+				if applyRules(property, rules[key]) && !contains(parent.Required, segment) {
+					parent.Required = append(parent.Required, segment)
+				}
+			}
+			parent = property
+		}
+	}
+
+	return schema
+}
+
+// copy Copy the schema or the component it references, the properties and the required fields aren't shared with it.
+func (s *schemas) copy(schema *Schema) *Schema {
+	copied := *s.resolve(schema)
+	if copied.Properties != nil {
+		properties := make(map[string]*Schema, len(copied.Properties))
+		for name, property := range copied.Properties {
+			properties[name] = property
+		}
+		copied.Properties = properties
+	}
+	copied.Required = append([]string(nil), copied.Required...)
+
+	return &copied
+}
+
+// applyRules Apply the rules to the schema, return true if the field is required.
+func applyRules(schema *Schema, rules string) bool {
+	var required bool
+	var constraints [][2]string
+	for _, item := range strings.Split(rules, "|") {
+		name, parameters, _ := strings.Cut(strings.TrimSpace(item), ":")
+		switch name {
+		case "required":
+			required = true
+		case "nullable":
+			schema.Nullable = true
+		case "string", "alpha", "alpha_num", "alpha_dash", "email", "url", "uuid", "ip", "ipv4", "ipv6", "date", "json":
+			if schema.Type == "" {
+				schema.Type = "string"
+			}
+		case "integer":
+			schema.Type = "integer"
+		case "numeric":
+			if schema.Type != "integer" {
+				schema.Type = "number"
+			}
+		case "boolean", "accepted":
+			if schema.Type == "" {
+				schema.Type = "boolean"
+			}
+		case "array":
+			if schema.Type == "" {
+				schema.Type = "array"
+				schema.Items = &Schema{}
+			}
+		}
+		constraints = append(constraints, [2]string{name, parameters})
+	}
+
+	// The constraints are applied after the type is known, min and max depend on the type.
+	for _, constraint := range constraints {
+		name, parameters := constraint[0], constraint[1]
+		switch name {
+		case "email":
+			schema.Format = "email"
+		case "url":
+			schema.Format = "uri"
+		case "uuid":
+			schema.Format = "uuid"
+		case "ipv4", "ipv6":
+			schema.Format = name
+		case "alpha":
+			schema.Pattern = "^[a-zA-Z]+$"
+		case "alpha_num":
+			schema.Pattern = "^[a-zA-Z0-9]+$"
+		case "alpha_dash":
+			schema.Pattern = "^[a-zA-Z0-9_-]+$"
+		case "regex":
+			schema.Pattern = parameters
+		case "in":
+			schema.Enum = nil
+			for _, value := range strings.Split(parameters, ",") {
+				schema.Enum = append(schema.Enum, enumValue(schema.Type, value))
+			}
+		case "min":
+			setMin(schema, parameters)
+		case "max":
+			setMax(schema, parameters)
+		case "size":
+			setMin(schema, parameters)
+			setMax(schema, parameters)
+		case "between":
+			lower, upper, _ := strings.Cut(parameters, ",")
+			setMin(schema, lower)
+			setMax(schema, upper)
+		}
+	}
+
+	return required
+}
+
+func setMin(schema *Schema, value string) {
+	number, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil {
+		return
+	}
+
+	switch schema.Type {
+	case "integer", "number":
+		schema.Minimum = &number
+	case "array":
+		length := int(number)
+		schema.MinItems = &length
+	default:
+		length := int(number)
+		schema.MinLength = &length
+	}
+}
+
+func setMax(schema *Schema, value string) {
+	number, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil {
+		return
+	}
+
+	switch schema.Type {
+	case "integer", "number":
+		schema.Maximum = &number
+	case "array":
+		length := int(number)
+		schema.MaxItems = &length
+	default:
+		length := int(number)
+		schema.MaxLength = &length
+	}
+}
+
+func enumValue(typ, value string) interface{} {
+	value = strings.TrimSpace(value)
+	switch typ {
+	case "integer":
+		if number, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return number
+		}
+	case "number":
+		if number, err := strconv.ParseFloat(value, 64); err == nil {
+			return number
+		}
+	case "boolean":
+		if boolean, err := strconv.ParseBool(value); err == nil {
+			return boolean
+		}
+	}
+
+	return value
+}
+
+type field struct {
+	name string
+	typ  reflect.Type
+}
+
+// fields Get the exported fields of the struct, the fields of the embedded structs are promoted if they have no tag.
+func fields(t reflect.Type, tag string) []field {
+	var result []field
+	for i := 0; i < t.NumField(); i++ {
+		structField := t.Field(i)
+		name, _, _ := strings.Cut(structField.Tag.Get(tag), ",")
+		if name == "-" {
+			continue
+		}
+
+		fieldType := structField.Type
+		for fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		if structField.Anonymous && name == "" && fieldType.Kind() == reflect.Struct {
+			result = append(result, fields(fieldType, tag)...)
+
+			continue
+		}
+		if !structField.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = structField.Name
+		}
+		result = append(result, field{name: name, typ: structField.Type})
+	}
+
+	return result
+}
+
+func contains(items []string, value string) bool {
+	for _, item := range items {
+		if item == value {
+			return true
+		}
+	}
+
+	return false
+}
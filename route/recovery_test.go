@@ -0,0 +1,40 @@
+package route_test
+
+import (
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/contracts/http"
+	contractsroute "github.com/goravel/framework/contracts/route"
+	"github.com/goravel/framework/route"
+	"github.com/goravel/framework/route/gin"
+	"github.com/goravel/framework/testing/mock"
+)
+
+func TestRecovery(t *testing.T) {
+	mockConfig := mock.Config()
+	mockConfig.On("GetBool", "app.debug").Return(false)
+	mock.Log()
+
+	for name, engine := range map[string]contractsroute.Engine{"gin": gin.NewRoute(), "nethttp": route.NewNetHttp()} {
+		engine.GlobalMiddleware(func(ctx http.Context) {
+			ctx.Response().Header("X-Global", "1")
+			ctx.Request().Next()
+		})
+		engine.Get("/panic", func(ctx http.Context) {
+			panic("boom")
+		})
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(nethttp.MethodGet, "/panic", nil)
+		req.Header.Set("Accept", "application/json")
+		engine.ServeHTTP(w, req)
+
+		assert.Equal(t, nethttp.StatusInternalServerError, w.Code, name)
+		assert.Equal(t, "1", w.Header().Get("X-Global"), name)
+		assert.Contains(t, w.Body.String(), `"message"`, name)
+	}
+}
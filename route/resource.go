@@ -0,0 +1,30 @@
+package route
+
+import (
+	"strings"
+
+	"github.com/goravel/framework/contracts/route"
+)
+
+// Resource Register the routes of a resource controller in a group, so the prefix and middlewares
+// of the current route apply to all of them. The routes are named like photos.index, photos.show,
+// create and edit routes are registered only if webController isn't nil.
+func Resource(router route.Route, path string, controller route.ApiResourceController, webController route.ResourceController) {
+	path = strings.Trim(path, "/")
+	name := strings.ReplaceAll(path, "/", ".")
+
+	router.Prefix(path).Group(func(router route.Route) {
+		router.Get("", controller.Index).Name(name + ".index")
+		if webController != nil {
+			router.Get("/create", webController.Create).Name(name + ".create")
+		}
+		router.Post("", controller.Store).Name(name + ".store")
+		router.Get("/{id}", controller.Show).Name(name + ".show")
+		if webController != nil {
+			router.Get("/{id}/edit", webController.Edit).Name(name + ".edit")
+		}
+		router.Put("/{id}", controller.Update).Name(name + ".update")
+		router.Patch("/{id}", controller.Update)
+		router.Delete("/{id}", controller.Destroy).Name(name + ".destroy")
+	})
+}
@@ -0,0 +1,287 @@
+package route
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"regexp"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cast"
+
+	httpcontract "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/contracts/route"
+	"github.com/goravel/framework/facades"
+	"github.com/goravel/framework/support/crypt"
+)
+
+var (
+	paramRegex       = regexp.MustCompile(`\{(.*?)\}`)
+	anonymousFuncReg = regexp.MustCompile(`(\.func\d+|\.\d+)+$`)
+)
+
+// Routes The route table of an engine, shared by all groups of the engine. The drivers register their routes in it,
+// so the route list, the OpenAPI spec and the urls are the same for all drivers.
+type Routes struct {
+	mu                sync.RWMutex
+	items             []*route.Info
+	byName            map[string]*route.Info
+	globalMiddlewares []string
+	domains           *domains
+}
+
+func NewRoutes() *Routes {
+	return &Routes{
+		byName:  make(map[string]*route.Info),
+		domains: &domains{},
+	}
+}
+
+// Add Register routes, the path uses the colon syntax and contains the group prefix.
+func (r *Routes) Add(methods []string, domain, path string, handler httpcontract.HandlerFunc, middlewares []httpcontract.Middleware) *Action {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	middlewareNames := append([]string{}, r.globalMiddlewares...)
+	for _, middleware := range middlewares {
+		middlewareNames = append(middlewareNames, funcName(middleware))
+	}
+
+	action := &Action{routes: r}
+	for _, method := range methods {
+		info := &route.Info{
+			Method:      method,
+			Domain:      domain,
+			Path:        colonToBracket(path),
+			Handler:     funcName(handler),
+			Middlewares: middlewareNames,
+		}
+		r.items = append(r.items, info)
+		action.items = append(action.items, info)
+	}
+
+	return action
+}
+
+func (r *Routes) AddGlobalMiddlewares(middlewares []httpcontract.Middleware) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, middleware := range middlewares {
+		r.globalMiddlewares = append(r.globalMiddlewares, funcName(middleware))
+	}
+}
+
+func (r *Routes) All() []route.Info {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	infos := make([]route.Info, 0, len(r.items))
+	for _, item := range r.items {
+		infos = append(infos, *item)
+	}
+
+	return infos
+}
+
+// Print Print the method and the path of the routes, the path is prefixed with the domain if the route has one.
+func (r *Routes) Print() {
+	for _, item := range r.All() {
+		fmt.Printf("%-10s %s\n", item.Method, item.Domain+item.Path)
+	}
+}
+
+func (r *Routes) Url(name string, params map[string]interface{}) (string, error) {
+	r.mu.RLock()
+	info, exist := r.byName[name]
+	r.mu.RUnlock()
+	if !exist {
+		return "", fmt.Errorf("route %s is not defined", name)
+	}
+
+	query := url.Values{}
+	for key, value := range params {
+		query.Set(key, cast.ToString(value))
+	}
+
+	var missing []string
+	fill := func(item string) string {
+		key := strings.TrimSuffix(strings.TrimPrefix(item, "{"), "}")
+		if !query.Has(key) {
+			missing = append(missing, key)
+
+			return item
+		}
+
+		value := query.Get(key)
+		query.Del(key)
+
+		return url.PathEscape(value)
+	}
+	path := paramRegex.ReplaceAllStringFunc(info.Path, fill)
+	if info.Domain != "" {
+		path = "//" + strings.ToLower(paramRegex.ReplaceAllStringFunc(info.Domain, fill)) + path
+	}
+	if len(missing) > 0 {
+		return "", fmt.Errorf("missing parameters for route %s: %s", name, strings.Join(missing, ", "))
+	}
+
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	return path, nil
+}
+
+// Signed Generate the url of a named route with a signature, the url expires at the expiration unless it's zero.
+// The host is signed if the route has a domain, so the url of a tenant can't be served by another one.
+func (r *Routes) Signed(name string, expiration time.Time, params map[string]interface{}) (string, error) {
+	path, err := r.Url(name, params)
+	if err != nil {
+		return "", err
+	}
+
+	return crypt.SignUrl(facades.Config.GetString("app.key"), path, expiration)
+}
+
+// Domain Get the domain of the pattern, the domain is created if it doesn't exist.
+func (r *Routes) Domain(pattern string) *Domain {
+	return r.domains.get(pattern)
+}
+
+// FindDomain Get the domain which has a route matching the request, and the parameters of the host.
+func (r *Routes) FindDomain(req *http.Request) (*Domain, map[string]string) {
+	return r.domains.find(req)
+}
+
+func (r *Routes) Domains() []*Domain {
+	return r.domains.all()
+}
+
+// OnCreateDomain Initialize the domains when they are created, e.g. the gin driver creates the engines of the domains.
+func (r *Routes) OnCreateDomain(create func(*Domain)) {
+	r.domains.mu.Lock()
+	defer r.domains.mu.Unlock()
+
+	r.domains.create = create
+}
+
+type Action struct {
+	routes *Routes
+	items  []*route.Info
+}
+
+func (r *Action) Name(name string) route.Action {
+	r.routes.mu.Lock()
+	defer r.routes.mu.Unlock()
+
+	for _, item := range r.items {
+		item.Name = name
+	}
+	if len(r.items) > 0 {
+		r.routes.byName[name] = r.items[0]
+	}
+
+	return r
+}
+
+func (r *Action) Summary(summary string) route.Action {
+	r.operation(func(operation *route.Operation) {
+		operation.Summary = summary
+	})
+
+	return r
+}
+
+func (r *Action) Description(description string) route.Action {
+	r.operation(func(operation *route.Operation) {
+		operation.Description = description
+	})
+
+	return r
+}
+
+func (r *Action) Tags(tags ...string) route.Action {
+	r.operation(func(operation *route.Operation) {
+		operation.Tags = append(operation.Tags, tags...)
+	})
+
+	return r
+}
+
+func (r *Action) Deprecated() route.Action {
+	r.operation(func(operation *route.Operation) {
+		operation.Deprecated = true
+	})
+
+	return r
+}
+
+func (r *Action) Request(request interface{}) route.Action {
+	r.operation(func(operation *route.Operation) {
+		operation.Request = request
+	})
+
+	return r
+}
+
+func (r *Action) Response(code int, response interface{}) route.Action {
+	r.operation(func(operation *route.Operation) {
+		operation.Responses[code] = response
+	})
+
+	return r
+}
+
+// operation Update the OpenAPI annotation of the routes, the routes of an action share the same annotation.
+func (r *Action) operation(update func(operation *route.Operation)) {
+	r.routes.mu.Lock()
+	defer r.routes.mu.Unlock()
+
+	if len(r.items) == 0 {
+		return
+	}
+	if r.items[0].Operation == nil {
+		operation := &route.Operation{Responses: make(map[int]interface{})}
+		for _, item := range r.items {
+			item.Operation = operation
+		}
+	}
+
+	update(r.items[0].Operation)
+}
+
+// setHandler Replace the handler name of the routes, it's used when the handler is wrapped.
+func (r *Action) setHandler(handler string) {
+	r.routes.mu.Lock()
+	defer r.routes.mu.Unlock()
+
+	for _, item := range r.items {
+		item.Handler = handler
+	}
+}
+
+// funcName Get the readable name of a handler or middleware: controllers.(*UserController).Show, middleware.Cors
+func funcName(fn interface{}) string {
+	value := reflect.ValueOf(fn)
+	if value.Kind() != reflect.Func || value.IsNil() {
+		return ""
+	}
+
+	runtimeFunc := runtime.FuncForPC(value.Pointer())
+	if runtimeFunc == nil {
+		return ""
+	}
+
+	name := runtimeFunc.Name()
+	if index := strings.LastIndex(name, "/"); index >= 0 {
+		name = name[index+1:]
+	}
+	name = strings.TrimSuffix(name, "-fm")
+
+	return anonymousFuncReg.ReplaceAllString(name, "")
+}
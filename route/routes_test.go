@@ -0,0 +1,141 @@
+package route
+
+import (
+	nethttp "net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/contracts/route"
+)
+
+func TestBracketToColon(t *testing.T) {
+	assert.Equal(t, "/:id/:name", bracketToColon("/{id}/{name}"))
+}
+
+func TestUrl(t *testing.T) {
+	engine := NewNetHttp()
+	engine.Prefix("api").Group(func(r route.Route) {
+		r.Get("users/{id}", func(ctx http.Context) {}).Name("users.show")
+		r.Prefix("teams/{team}").Group(func(r route.Route) {
+			r.Put("members/{member}", func(ctx http.Context) {}).Name("teams.members.update")
+		})
+	})
+	engine.Any("/", func(ctx http.Context) {}).Name("home")
+
+	tests := []struct {
+		name      string
+		params    map[string]interface{}
+		expectUrl string
+		expectErr bool
+	}{
+		{
+			name:      "users.show",
+			params:    map[string]interface{}{"id": 1},
+			expectUrl: "/api/users/1",
+		},
+		{
+			name:      "users.show",
+			params:    map[string]interface{}{"id": "a b", "page": 2, "sort": "name"},
+			expectUrl: "/api/users/a%20b?page=2&sort=name",
+		},
+		{
+			name:      "teams.members.update",
+			params:    map[string]interface{}{"team": 1, "member": 2},
+			expectUrl: "/api/teams/1/members/2",
+		},
+		{
+			name:      "home",
+			expectUrl: "/",
+		},
+		{
+			name:      "users.show",
+			params:    map[string]interface{}{"name": "goravel"},
+			expectErr: true,
+		},
+		{
+			name:      "users.index",
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		url, err := engine.Url(test.name, test.params)
+		if test.expectErr {
+			assert.NotNil(t, err)
+		} else {
+			assert.Nil(t, err)
+			assert.Equal(t, test.expectUrl, url)
+		}
+	}
+}
+
+type PhotoController struct {
+}
+
+func (c *PhotoController) Index(ctx http.Context) {
+	ctx.Response().String(nethttp.StatusOK, "index")
+}
+
+func (c *PhotoController) Create(ctx http.Context) {
+	ctx.Response().String(nethttp.StatusOK, "create")
+}
+
+func (c *PhotoController) Store(ctx http.Context) {
+	ctx.Response().String(nethttp.StatusCreated, "store")
+}
+
+func (c *PhotoController) Show(ctx http.Context) {
+	ctx.Response().String(nethttp.StatusOK, "show "+ctx.Request().Input("id"))
+}
+
+func (c *PhotoController) Edit(ctx http.Context) {
+	ctx.Response().String(nethttp.StatusOK, "edit "+ctx.Request().Input("id"))
+}
+
+func (c *PhotoController) Update(ctx http.Context) {
+	ctx.Response().String(nethttp.StatusOK, "update "+ctx.Request().Input("id"))
+}
+
+func (c *PhotoController) Destroy(ctx http.Context) {
+	ctx.Response().String(nethttp.StatusOK, "destroy "+ctx.Request().Input("id"))
+}
+
+func TestRouteOperation(t *testing.T) {
+	type User struct {
+		Name string `json:"name"`
+	}
+
+	engine := NewNetHttp()
+	engine.Any("users", func(ctx http.Context) {}).
+		Summary("Users").
+		Description("Manage users").
+		Tags("users").
+		Tags("admin").
+		Deprecated().
+		Request(&User{}).
+		Response(200, []User{}).
+		Response(204, nil)
+	engine.Get("photos", func(ctx http.Context) {})
+
+	routes := engine.Routes()
+	operation := &route.Operation{
+		Summary:     "Users",
+		Description: "Manage users",
+		Tags:        []string{"users", "admin"},
+		Deprecated:  true,
+		Request:     &User{},
+		Responses:   map[int]interface{}{200: []User{}, 204: nil},
+	}
+	for _, item := range routes[:len(routes)-1] {
+		assert.Equal(t, operation, item.Operation, item.Method)
+	}
+	assert.Nil(t, routes[len(routes)-1].Operation)
+}
+
+func testMiddleware() http.Middleware {
+	return func(ctx http.Context) {
+		ctx.Request().Next()
+	}
+}
@@ -0,0 +1,95 @@
+package route
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/gookit/color"
+
+	"github.com/goravel/framework/facades"
+)
+
+// Server Serve a route.Engine through http.Server, it's shared by the drivers.
+type Server struct {
+	mu       sync.Mutex
+	instance *http.Server
+	// closed The shutdown is requested, the server isn't started if it's requested before run.
+	closed bool
+}
+
+// Run Start serving the handler, HTTP/2 is enabled automatically when certFile and keyFile are set.
+// It blocks until the server is closed, SIGINT and SIGTERM shutdown the server gracefully.
+// http.ErrServerClosed is returned without serving if Shutdown is called before it.
+func (s *Server) Run(handler http.Handler, addr, certFile, keyFile string) error {
+	instance := &http.Server{
+		Addr:              addr,
+		Handler:           handler,
+		ReadTimeout:       configDuration("http.read_timeout"),
+		ReadHeaderTimeout: configDuration("http.read_header_timeout"),
+		WriteTimeout:      configDuration("http.write_timeout"),
+		IdleTimeout:       configDuration("http.idle_timeout"),
+	}
+
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+
+		return http.ErrServerClosed
+	}
+	s.instance = instance
+	s.mu.Unlock()
+
+	errs := make(chan error, 1)
+	go func() {
+		if certFile != "" || keyFile != "" {
+			errs <- instance.ListenAndServeTLS(certFile, keyFile)
+		} else {
+			errs <- instance.ListenAndServe()
+		}
+	}()
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(quit)
+
+	select {
+	case err := <-errs:
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+
+		return err
+	case <-quit:
+		color.Greenln("Shutting down HTTP server...")
+
+		ctx, cancel := context.WithTimeout(context.Background(), configDuration("http.shutdown_timeout", 10))
+		defer cancel()
+
+		return s.Shutdown(ctx)
+	}
+}
+
+// Shutdown Stop accepting new connections and wait for the in-flight requests until ctx is done.
+func (s *Server) Shutdown(ctx context.Context) error {
+	s.mu.Lock()
+	s.closed = true
+	instance := s.instance
+	s.mu.Unlock()
+
+	if instance == nil {
+		return nil
+	}
+
+	return instance.Shutdown(ctx)
+}
+
+// configDuration Get a duration from config, the unit of the value is second.
+func configDuration(path string, defaultValue ...interface{}) time.Duration {
+	return time.Duration(facades.Config.GetInt(path, defaultValue...)) * time.Second
+}
@@ -0,0 +1,166 @@
+package route_test
+
+import (
+	"context"
+	"io"
+	"net"
+	nethttp "net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/contracts/http"
+	contractsroute "github.com/goravel/framework/contracts/route"
+	"github.com/goravel/framework/route"
+	"github.com/goravel/framework/route/gin"
+	"github.com/goravel/framework/testing/mock"
+)
+
+func TestShutdown(t *testing.T) {
+	engines := map[string]func() contractsroute.Engine{
+		"gin":     gin.NewRoute,
+		"nethttp": route.NewNetHttp,
+	}
+
+	for name, newEngine := range engines {
+		t.Run(name, func(t *testing.T) {
+			mockConfig := mock.Config()
+			mockConfig.On("GetBool", "app.debug").Return(false)
+			for _, path := range []string{"http.read_timeout", "http.read_header_timeout", "http.write_timeout", "http.idle_timeout"} {
+				mockConfig.On("GetInt", path).Return(0).Once()
+			}
+
+			started := make(chan struct{})
+			engine := newEngine()
+			engine.Get("/slow", func(ctx http.Context) {
+				close(started)
+				time.Sleep(100 * time.Millisecond)
+				ctx.Response().String(nethttp.StatusOK, "done")
+			})
+
+			addr := freeAddr(t)
+			errs := make(chan error, 1)
+			go func() {
+				errs <- engine.Run(addr)
+			}()
+
+			var resp *nethttp.Response
+			var err error
+			responses := make(chan struct{})
+			go func() {
+				defer close(responses)
+				for i := 0; i < 50; i++ {
+					if resp, err = nethttp.Get("http://" + addr + "/slow"); err == nil {
+						return
+					}
+					time.Sleep(10 * time.Millisecond)
+				}
+			}()
+
+			<-started
+			assert.Nil(t, engine.Shutdown(context.Background()))
+			assert.Nil(t, <-errs)
+
+			<-responses
+			assert.Nil(t, err)
+			body, _ := io.ReadAll(resp.Body)
+			_ = resp.Body.Close()
+			assert.Equal(t, "done", string(body))
+
+			mockConfig.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRunWithDomain(t *testing.T) {
+	engines := map[string]func() contractsroute.Engine{
+		"gin":     gin.NewRoute,
+		"nethttp": route.NewNetHttp,
+	}
+
+	for name, newEngine := range engines {
+		t.Run(name, func(t *testing.T) {
+			mockConfig := mock.Config()
+			mockConfig.On("GetBool", "app.debug").Return(false)
+			for _, path := range []string{"http.read_timeout", "http.read_header_timeout", "http.write_timeout", "http.idle_timeout"} {
+				mockConfig.On("GetInt", path).Return(0).Once()
+			}
+
+			engine := newEngine()
+			engine.Domain("{tenant}.goravel.dev").Get("/", func(ctx http.Context) {
+				ctx.Response().String(nethttp.StatusOK, "tenant: "+ctx.Request().Input("tenant"))
+			})
+			engine.Get("/", func(ctx http.Context) {
+				ctx.Response().String(nethttp.StatusOK, "main")
+			})
+
+			addr := freeAddr(t)
+			errs := make(chan error, 1)
+			go func() {
+				errs <- engine.Run(addr)
+			}()
+
+			get := func(host string) string {
+				req, err := nethttp.NewRequest(nethttp.MethodGet, "http://"+addr+"/", nil)
+				assert.Nil(t, err)
+				req.Host = host
+
+				var resp *nethttp.Response
+				for i := 0; i < 50; i++ {
+					if resp, err = nethttp.DefaultClient.Do(req); err == nil {
+						break
+					}
+					time.Sleep(10 * time.Millisecond)
+				}
+				if !assert.Nil(t, err) {
+					return ""
+				}
+				body, _ := io.ReadAll(resp.Body)
+				_ = resp.Body.Close()
+
+				return string(body)
+			}
+
+			assert.Equal(t, "tenant: acme", get("acme.goravel.dev"))
+			assert.Equal(t, "main", get("goravel.dev"))
+
+			assert.Nil(t, engine.Shutdown(context.Background()))
+			assert.Nil(t, <-errs)
+		})
+	}
+}
+
+func TestShutdownBeforeRun(t *testing.T) {
+	engines := map[string]func() contractsroute.Engine{
+		"gin":     gin.NewRoute,
+		"nethttp": route.NewNetHttp,
+	}
+
+	for name, newEngine := range engines {
+		t.Run(name, func(t *testing.T) {
+			mockConfig := mock.Config()
+			mockConfig.On("GetBool", "app.debug").Return(false)
+			for _, path := range []string{"http.read_timeout", "http.read_header_timeout", "http.write_timeout", "http.idle_timeout"} {
+				mockConfig.On("GetInt", path).Return(0).Once()
+			}
+
+			engine := newEngine()
+			assert.Nil(t, engine.Shutdown(context.Background()))
+
+			addr := freeAddr(t)
+			assert.ErrorIs(t, engine.Run(addr), nethttp.ErrServerClosed)
+			_, err := nethttp.Get("http://" + addr)
+			assert.NotNil(t, err)
+		})
+	}
+}
+
+func freeAddr(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	addr := listener.Addr().String()
+	assert.Nil(t, listener.Close())
+
+	return addr
+}
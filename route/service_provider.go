@@ -1,7 +1,10 @@
 package route
 
 import (
+	consolecontract "github.com/goravel/framework/contracts/console"
 	"github.com/goravel/framework/facades"
+	"github.com/goravel/framework/route/console"
+	"github.com/goravel/framework/route/openapi"
 )
 
 type ServiceProvider struct {
@@ -9,9 +12,28 @@ type ServiceProvider struct {
 
 func (route *ServiceProvider) Register() {
 	app := Application{}
-	facades.Route = app.Init()
+	engine, err := app.Init()
+	if err != nil {
+		panic(err.Error())
+	}
+	facades.Route = engine
 }
 
 func (route *ServiceProvider) Boot() {
+	route.registerCommands()
+	route.registerOpenApi()
+}
+
+func (route *ServiceProvider) registerCommands() {
+	facades.Artisan.Register([]consolecontract.Command{
+		&console.ListCommand{},
+		&console.OpenApiGenerateCommand{},
+	})
+}
 
+// registerOpenApi Serve the OpenAPI spec if the openapi.path config is set: /openapi.json
+func (route *ServiceProvider) registerOpenApi() {
+	if path := facades.Config.GetString("openapi.path"); path != "" && facades.Route != nil {
+		facades.Route.Get(path, openapi.Handler)
+	}
 }
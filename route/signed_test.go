@@ -0,0 +1,84 @@
+package route_test
+
+import (
+	nethttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/contracts/http"
+	contractsroute "github.com/goravel/framework/contracts/route"
+	"github.com/goravel/framework/http/middleware"
+	"github.com/goravel/framework/route"
+	"github.com/goravel/framework/route/gin"
+	"github.com/goravel/framework/testing/mock"
+)
+
+func TestSigned(t *testing.T) {
+	mockConfig := mock.Config()
+	mockConfig.On("GetBool", "app.debug").Return(false)
+	mockConfig.On("GetString", "app.key").Return("12345678901234567890123456789012")
+
+	engines := map[string]contractsroute.Engine{"gin": gin.NewRoute(), "nethttp": route.NewNetHttp()}
+	for name, engine := range engines {
+		engine.Middleware(middleware.ValidateSignature()).Get("/unsubscribe/{user}", func(ctx http.Context) {
+			ctx.Response().String(nethttp.StatusOK, "unsubscribed "+ctx.Request().Input("user"))
+		}).Name("unsubscribe")
+		engine.Domain("{tenant}.example.com").Middleware(middleware.ValidateSignature()).Get("/invoices/{id}", func(ctx http.Context) {
+			ctx.Response().String(nethttp.StatusOK, "invoice "+ctx.Request().Input("id")+" of "+ctx.Request().Input("tenant"))
+		}).Name("tenant.invoices.show")
+
+		signed, err := engine.Signed("unsubscribe", map[string]interface{}{"user": 1, "list": "news"})
+		assert.Nil(t, err, name)
+		assert.True(t, strings.HasPrefix(signed, "/unsubscribe/1?list=news&signature="), name)
+
+		temporary, err := engine.TemporarySigned("unsubscribe", time.Now().Add(time.Hour), map[string]interface{}{"user": 1})
+		assert.Nil(t, err, name)
+		assert.Contains(t, temporary, "expires=", name)
+
+		expired, err := engine.TemporarySigned("unsubscribe", time.Now().Add(-time.Minute), map[string]interface{}{"user": 1})
+		assert.Nil(t, err, name)
+
+		invoice, err := engine.Signed("tenant.invoices.show", map[string]interface{}{"tenant": "Acme", "id": 1})
+		assert.Nil(t, err, name)
+		assert.True(t, strings.HasPrefix(invoice, "//acme.example.com/invoices/1?signature="), name)
+		invoice = strings.TrimPrefix(invoice, "//acme.example.com")
+
+		_, err = engine.Signed("missing", nil)
+		assert.NotNil(t, err, name)
+		_, err = engine.Signed("tenant.invoices.show", map[string]interface{}{"id": 1})
+		assert.NotNil(t, err, name)
+
+		tests := []struct {
+			host       string
+			url        string
+			expectCode int
+			expectBody string
+		}{
+			{url: signed, expectCode: nethttp.StatusOK, expectBody: "unsubscribed 1"},
+			{url: temporary, expectCode: nethttp.StatusOK, expectBody: "unsubscribed 1"},
+			{url: strings.Replace(signed, "/unsubscribe/1", "/unsubscribe/2", 1), expectCode: nethttp.StatusForbidden, expectBody: `{"message":"Invalid signature."}`},
+			{url: strings.Replace(signed, "list=news", "list=all", 1), expectCode: nethttp.StatusForbidden, expectBody: `{"message":"Invalid signature."}`},
+			{url: expired, expectCode: nethttp.StatusForbidden, expectBody: `{"message":"Signature has expired."}`},
+			{url: "/unsubscribe/1", expectCode: nethttp.StatusForbidden, expectBody: `{"message":"Invalid signature."}`},
+			{host: "acme.example.com:8080", url: invoice, expectCode: nethttp.StatusOK, expectBody: "invoice 1 of acme"},
+			{host: "other.example.com", url: invoice, expectCode: nethttp.StatusForbidden, expectBody: `{"message":"Invalid signature."}`},
+		}
+
+		for _, test := range tests {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(nethttp.MethodGet, test.url, nil)
+			req.Header.Set("Accept", "application/json")
+			if test.host != "" {
+				req.Host = test.host
+			}
+			engine.ServeHTTP(w, req)
+
+			assert.Equal(t, test.expectCode, w.Code, name+" "+test.url)
+			assert.Equal(t, test.expectBody, w.Body.String(), name+" "+test.url)
+		}
+	}
+}
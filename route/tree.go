@@ -0,0 +1,203 @@
+package route
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	httpcontract "github.com/goravel/framework/contracts/http"
+)
+
+// tree The router of the net/http driver, a trie per method which supports static segments,
+// parameters (/users/:id) and a trailing catch-all parameter (/assets/*filepath).
+type tree struct {
+	roots map[string]*node
+}
+
+type node struct {
+	children map[string]*node
+	param    *node
+	wildcard *node
+	// name The name of the parameter if the node is a param or wildcard node.
+	name     string
+	path     string
+	handlers []httpcontract.HandlerFunc
+}
+
+func newTree() *tree {
+	return &tree{roots: make(map[string]*node)}
+}
+
+func newNode() *node {
+	return &node{children: make(map[string]*node)}
+}
+
+// add Register the handlers of a path, the path uses the colon syntax: /users/:id
+func (t *tree) add(method, path string, handlers []httpcontract.HandlerFunc) {
+	root, exist := t.roots[method]
+	if !exist {
+		root = newNode()
+		t.roots[method] = root
+	}
+
+	current := root
+	segments := splitPath(path)
+	for i, segment := range segments {
+		switch {
+		case strings.HasPrefix(segment, ":"):
+			name := segment[1:]
+			if current.param == nil {
+				current.param = newNode()
+				current.param.name = name
+			} else if current.param.name != name {
+				panic(fmt.Sprintf("'%s' in new path '%s' conflicts with existing parameter ':%s'", segment, path, current.param.name))
+			}
+			current = current.param
+		case strings.HasPrefix(segment, "*"):
+			if i != len(segments)-1 {
+				panic(fmt.Sprintf("catch-all routes are only allowed at the end of the path in path '%s'", path))
+			}
+			if current.wildcard == nil {
+				current.wildcard = newNode()
+				current.wildcard.name = segment[1:]
+			}
+			current = current.wildcard
+		default:
+			child, exist := current.children[segment]
+			if !exist {
+				child = newNode()
+				current.children[segment] = child
+			}
+			current = child
+		}
+	}
+
+	if current.handlers != nil {
+		panic(fmt.Sprintf("handlers are already registered for path '%s'", path))
+	}
+
+	current.path = path
+	current.handlers = handlers
+}
+
+// find Get the handlers and the parameters of a request path, static segments take precedence over parameters.
+func (t *tree) find(method, path string) ([]httpcontract.HandlerFunc, map[string]string, bool) {
+	root, exist := t.roots[method]
+	if !exist {
+		return nil, nil, false
+	}
+
+	params := make(map[string]string)
+	matched := root.match(splitPath(path), params)
+	if matched == nil {
+		return nil, nil, false
+	}
+
+	return matched.handlers, params, true
+}
+
+// allowed Get the methods which have a route matching the path, they are sorted: GET, POST
+func (t *tree) allowed(path string) []string {
+	var methods []string
+	for method := range t.roots {
+		if _, _, found := t.find(method, path); found {
+			methods = append(methods, method)
+		}
+	}
+	sort.Strings(methods)
+
+	return methods
+}
+
+func (n *node) match(segments []string, params map[string]string) *node {
+	if len(segments) == 0 {
+		if n.handlers != nil {
+			return n
+		}
+		if n.wildcard != nil && n.wildcard.handlers != nil {
+			params[n.wildcard.name] = "/"
+
+			return n.wildcard
+		}
+
+		return nil
+	}
+
+	segment := segments[0]
+	if child, exist := n.children[segment]; exist {
+		if matched := child.match(segments[1:], params); matched != nil {
+			return matched
+		}
+	}
+
+	if n.param != nil && segment != "" {
+		if matched := n.param.match(segments[1:], params); matched != nil {
+			params[n.param.name] = segment
+
+			return matched
+		}
+	}
+
+	if n.wildcard != nil && n.wildcard.handlers != nil {
+		params[n.wildcard.name] = "/" + strings.Join(segments, "/")
+
+		return n.wildcard
+	}
+
+	return nil
+}
+
+// splitPath Split a path into segments, a trailing slash is ignored: /users/ equals /users
+func splitPath(path string) []string {
+	path = strings.Trim(path, "/")
+	if path == "" {
+		return nil
+	}
+
+	return strings.Split(path, "/")
+}
+
+// PathMatches Determine if the path matches the pattern which uses the colon syntax: /users/:id
+func PathMatches(pattern, path string) bool {
+	t := newTree()
+	t.add("", pattern, []httpcontract.HandlerFunc{})
+	_, _, found := t.find("", path)
+
+	return found
+}
+
+// NormalizePath Merge the slashes of a path and convert the parameters to the colon syntax: /users/{id} -> /users/:id
+func NormalizePath(relativePath string) string {
+	return bracketToColon(mergeSlashForPath(relativePath))
+}
+
+func colonToBracket(relativePath string) string {
+	arr := strings.Split(relativePath, "/")
+	var newArr []string
+	for _, item := range arr {
+		if strings.HasPrefix(item, ":") {
+			item = "{" + strings.ReplaceAll(item, ":", "") + "}"
+		}
+		newArr = append(newArr, item)
+	}
+
+	return strings.Join(newArr, "/")
+}
+
+func bracketToColon(relativePath string) string {
+	compileRegex := regexp.MustCompile("\\{(.*?)\\}")
+	matchArr := compileRegex.FindAllStringSubmatch(relativePath, -1)
+
+	for _, item := range matchArr {
+		relativePath = strings.ReplaceAll(relativePath, item[0], ":"+item[1])
+	}
+
+	return relativePath
+}
+
+func mergeSlashForPath(path string) string {
+	path = strings.ReplaceAll(path, "//", "/")
+
+	return strings.ReplaceAll(path, "//", "/")
+}
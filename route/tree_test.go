@@ -0,0 +1,58 @@
+package route
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/contracts/http"
+)
+
+func TestTree(t *testing.T) {
+	handler := func(name string) []http.HandlerFunc {
+		return []http.HandlerFunc{func(ctx http.Context) {
+			ctx.WithValue("handler", name)
+		}}
+	}
+
+	tr := newTree()
+	tr.add("GET", "/users", handler("index"))
+	tr.add("GET", "/users/create", handler("create"))
+	tr.add("GET", "/users/:id", handler("show"))
+	tr.add("GET", "/users/:id/posts/:post", handler("post"))
+	tr.add("GET", "/assets/*filepath", handler("assets"))
+
+	tests := []struct {
+		path         string
+		expectFound  bool
+		expectParams map[string]string
+	}{
+		{path: "/users", expectFound: true, expectParams: map[string]string{}},
+		{path: "/users/", expectFound: true, expectParams: map[string]string{}},
+		{path: "/users/create", expectFound: true, expectParams: map[string]string{}},
+		{path: "/users/1", expectFound: true, expectParams: map[string]string{"id": "1"}},
+		{path: "/users/1/posts/2", expectFound: true, expectParams: map[string]string{"id": "1", "post": "2"}},
+		{path: "/assets/css/app.css", expectFound: true, expectParams: map[string]string{"filepath": "/css/app.css"}},
+		{path: "/assets", expectFound: true, expectParams: map[string]string{"filepath": "/"}},
+		{path: "/users/1/posts", expectFound: false},
+		{path: "/teams", expectFound: false},
+	}
+
+	for _, test := range tests {
+		_, params, found := tr.find("GET", test.path)
+		assert.Equal(t, test.expectFound, found, test.path)
+		if test.expectFound {
+			assert.Equal(t, test.expectParams, params, test.path)
+		}
+	}
+
+	_, _, found := tr.find("POST", "/users")
+	assert.False(t, found)
+
+	assert.Panics(t, func() {
+		tr.add("GET", "/users/:name", handler("conflict"))
+	})
+	assert.Panics(t, func() {
+		tr.add("GET", "/users", handler("duplicate"))
+	})
+}
@@ -0,0 +1,25 @@
+package route
+
+import (
+	httpcontract "github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/contracts/route"
+	frameworkhttp "github.com/goravel/framework/http"
+)
+
+// WebSocket Register a GET route, the connection is upgraded after the middlewares of the group pass.
+func WebSocket(router route.Route, relativePath string, handler httpcontract.WebSocketHandler) route.Action {
+	action := router.Get(relativePath, func(ctx httpcontract.Context) {
+		conn, err := frameworkhttp.UpgradeWebSocket(ctx.Response().Writer(), ctx.Request().Origin())
+		if err != nil {
+			return
+		}
+		defer conn.Close(httpcontract.WebSocketCloseNormalClosure, "")
+
+		handler(ctx, conn)
+	})
+	if item, ok := action.(*Action); ok {
+		item.setHandler(funcName(handler))
+	}
+
+	return action
+}
@@ -0,0 +1,83 @@
+package route_test
+
+import (
+	nethttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/contracts/http"
+	contractsroute "github.com/goravel/framework/contracts/route"
+	"github.com/goravel/framework/route"
+	"github.com/goravel/framework/route/gin"
+	"github.com/goravel/framework/testing/mock"
+)
+
+type webSocketMessage struct {
+	User    string `json:"user"`
+	Message string `json:"message"`
+}
+
+func TestWebSocket(t *testing.T) {
+	engines := map[string]func() contractsroute.Engine{
+		"gin":     gin.NewRoute,
+		"nethttp": route.NewNetHttp,
+	}
+
+	for name, newEngine := range engines {
+		t.Run(name, func(t *testing.T) {
+			mockConfig := mock.Config()
+			mockConfig.On("GetBool", "app.debug").Return(false)
+			mockConfig.On("GetInt", "http.websocket.ping_interval", 30).Return(30)
+			mockConfig.On("GetInt", "http.websocket.pong_timeout", 60).Return(60)
+
+			engine := newEngine()
+			engine.Prefix("ws").Middleware(func(ctx http.Context) {
+				if ctx.Request().Query("token", "") != "goravel" {
+					ctx.Request().AbortWithStatus(nethttp.StatusUnauthorized)
+
+					return
+				}
+				ctx.WithValue("user", "goravel")
+				ctx.Request().Next()
+			}).WebSocket("chat", func(ctx http.Context, conn http.WebSocketConnection) {
+				for {
+					var message webSocketMessage
+					if err := conn.ReadJson(&message); err != nil {
+						return
+					}
+					message.User = ctx.Value("user").(string)
+					if err := conn.WriteJson(message); err != nil {
+						return
+					}
+				}
+			})
+
+			server := httptest.NewServer(engine)
+			defer server.Close()
+			url := "ws" + strings.TrimPrefix(server.URL, "http") + "/ws/chat"
+
+			_, resp, err := websocket.DefaultDialer.Dial(url, nil)
+			assert.NotNil(t, err)
+			assert.Equal(t, nethttp.StatusUnauthorized, resp.StatusCode)
+
+			conn, _, err := websocket.DefaultDialer.Dial(url+"?token=goravel", nil)
+			assert.Nil(t, err)
+			assert.Nil(t, conn.WriteJSON(webSocketMessage{Message: "hello"}))
+
+			var message webSocketMessage
+			assert.Nil(t, conn.ReadJSON(&message))
+			assert.Equal(t, webSocketMessage{User: "goravel", Message: "hello"}, message)
+
+			assert.Nil(t, conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")))
+			_, _, err = conn.ReadMessage()
+			assert.True(t, websocket.IsCloseError(err, websocket.CloseNormalClosure))
+			assert.Nil(t, conn.Close())
+
+			assert.Equal(t, "route_test.TestWebSocket", engine.Routes()[0].Handler)
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package session
+
+import (
+	nethttp "net/http"
+
+	"github.com/goravel/framework/facades"
+	"github.com/goravel/framework/http"
+)
+
+// NewCookie Create a cookie with the session config, the cookie expires when the browser is closed if maxAge is 0.
+func NewCookie(name, value string, maxAge int) *nethttp.Cookie {
+	return &nethttp.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     facades.Config.GetString("session.path", "/"),
+		Domain:   facades.Config.GetString("session.domain"),
+		MaxAge:   maxAge,
+		Secure:   facades.Config.GetBool("session.secure"),
+		HttpOnly: facades.Config.GetBool("session.http_only", true),
+		SameSite: http.ParseSameSite(facades.Config.GetString("session.same_site", "lax")),
+	}
+}
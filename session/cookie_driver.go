@@ -0,0 +1,93 @@
+package session
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	sessioncontract "github.com/goravel/framework/contracts/session"
+	"github.com/goravel/framework/facades"
+	"github.com/goravel/framework/support/crypt"
+)
+
+// CookieDriver Store the sessions in the cookies of the client, the data is encrypted with app.key.
+type CookieDriver struct {
+	minutes int
+	request *http.Request
+	writer  http.ResponseWriter
+}
+
+type cookiePayload struct {
+	Data    string `json:"data"`
+	Expires int64  `json:"expires"`
+}
+
+func NewCookieDriver(minutes int) *CookieDriver {
+	return &CookieDriver{minutes: minutes}
+}
+
+func (c *CookieDriver) WithRequest(w http.ResponseWriter, r *http.Request) sessioncontract.Driver {
+	return &CookieDriver{
+		minutes: c.minutes,
+		request: r,
+		writer:  w,
+	}
+}
+
+func (c *CookieDriver) Read(id string) (string, error) {
+	if c.request == nil {
+		return "", nil
+	}
+
+	cookie, err := c.request.Cookie(id)
+	if err != nil {
+		return "", nil
+	}
+
+	value, err := crypt.Decrypt(facades.Config.GetString("app.key"), cookie.Value)
+	if err != nil {
+		return "", nil
+	}
+
+	var payload cookiePayload
+	if err := json.Unmarshal(value, &payload); err != nil || payload.Expires < time.Now().Unix() {
+		return "", nil
+	}
+
+	return payload.Data, nil
+}
+
+func (c *CookieDriver) Write(id string, data string) error {
+	if c.writer == nil {
+		return nil
+	}
+
+	value, err := json.Marshal(cookiePayload{
+		Data:    data,
+		Expires: time.Now().Add(time.Duration(c.minutes) * time.Minute).Unix(),
+	})
+	if err != nil {
+		return err
+	}
+
+	payload, err := crypt.Encrypt(facades.Config.GetString("app.key"), value)
+	if err != nil {
+		return err
+	}
+
+	http.SetCookie(c.writer, NewCookie(id, payload, c.minutes*60))
+
+	return nil
+}
+
+func (c *CookieDriver) Destroy(id string) error {
+	if c.writer != nil {
+		http.SetCookie(c.writer, NewCookie(id, "", -1))
+	}
+
+	return nil
+}
+
+func (c *CookieDriver) Gc(maxLifetime int) error {
+	return nil
+}
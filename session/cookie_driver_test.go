@@ -0,0 +1,49 @@
+package session
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/testing/mock"
+)
+
+func TestCookieDriver(t *testing.T) {
+	mockConfig := mock.Config()
+	mockConfig.On("GetString", "app.key").Return("12345678901234567890123456789012")
+	mockConfig.On("GetString", "session.path", "/").Return("/")
+	mockConfig.On("GetString", "session.domain").Return("")
+	mockConfig.On("GetBool", "session.secure").Return(false)
+	mockConfig.On("GetBool", "session.http_only", true).Return(true)
+	mockConfig.On("GetString", "session.same_site", "lax").Return("lax")
+
+	w := httptest.NewRecorder()
+	driver := NewCookieDriver(120).WithRequest(w, httptest.NewRequest(http.MethodGet, "/", nil))
+	data, err := driver.Read("goravel")
+	assert.Nil(t, err)
+	assert.Empty(t, data)
+	assert.Nil(t, driver.Write("goravel", `{"name":"goravel"}`))
+
+	cookies := w.Result().Cookies()
+	assert.Len(t, cookies, 1)
+	assert.Equal(t, "goravel", cookies[0].Name)
+	assert.Equal(t, 7200, cookies[0].MaxAge)
+	assert.True(t, cookies[0].HttpOnly)
+	assert.NotContains(t, cookies[0].Value, "goravel")
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.AddCookie(cookies[0])
+	driver = NewCookieDriver(120).WithRequest(httptest.NewRecorder(), req)
+	data, err = driver.Read("goravel")
+	assert.Nil(t, err)
+	assert.Equal(t, `{"name":"goravel"}`, data)
+
+	req = httptest.NewRequest(http.MethodGet, "/", nil)
+	req.AddCookie(&http.Cookie{Name: "goravel", Value: "invalid"})
+	driver = NewCookieDriver(120).WithRequest(httptest.NewRecorder(), req)
+	data, err = driver.Read("goravel")
+	assert.Nil(t, err)
+	assert.Empty(t, data)
+}
@@ -0,0 +1,79 @@
+package session
+
+import (
+	"time"
+
+	"github.com/goravel/framework/contracts/database/orm"
+	"github.com/goravel/framework/facades"
+)
+
+// DatabaseDriver Store the sessions in a table via facades.Orm, the table should have the columns:
+// id (varchar primary key), payload (text) and last_activity (int, indexed).
+type DatabaseDriver struct {
+	connection string
+	table      string
+	lifetime   time.Duration
+}
+
+type databaseSession struct {
+	ID           string `gorm:"primaryKey"`
+	Payload      string
+	LastActivity int64
+}
+
+func NewDatabaseDriver(connection, table string, minutes int) *DatabaseDriver {
+	return &DatabaseDriver{
+		connection: connection,
+		table:      table,
+		lifetime:   time.Duration(minutes) * time.Minute,
+	}
+}
+
+func (d *DatabaseDriver) Read(id string) (string, error) {
+	var sessions []databaseSession
+	if err := d.query().Table(d.table).Where("id = ?", id).Find(&sessions); err != nil {
+		return "", err
+	}
+	if len(sessions) == 0 || sessions[0].LastActivity < time.Now().Add(-d.lifetime).Unix() {
+		return "", nil
+	}
+
+	return sessions[0].Payload, nil
+}
+
+func (d *DatabaseDriver) Write(id string, data string) error {
+	var count int64
+	if err := d.query().Table(d.table).Where("id = ?", id).Count(&count); err != nil {
+		return err
+	}
+
+	now := time.Now().Unix()
+	if count > 0 {
+		return d.query().Table(d.table).Where("id = ?", id).Updates(map[string]interface{}{
+			"payload":       data,
+			"last_activity": now,
+		})
+	}
+
+	return d.query().Table(d.table).Create(&databaseSession{
+		ID:           id,
+		Payload:      data,
+		LastActivity: now,
+	})
+}
+
+func (d *DatabaseDriver) Destroy(id string) error {
+	return d.query().Table(d.table).Where("id = ?", id).Delete(&databaseSession{})
+}
+
+func (d *DatabaseDriver) Gc(maxLifetime int) error {
+	return d.query().Table(d.table).Where("last_activity <= ?", time.Now().Unix()-int64(maxLifetime)).Delete(&databaseSession{})
+}
+
+func (d *DatabaseDriver) query() orm.DB {
+	if d.connection != "" {
+		return facades.Orm.Connection(d.connection).Query()
+	}
+
+	return facades.Orm.Query()
+}
@@ -0,0 +1,93 @@
+package session
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// FileDriver Store the sessions in the local files, a file per session.
+type FileDriver struct {
+	path     string
+	lifetime time.Duration
+}
+
+func NewFileDriver(path string, minutes int) *FileDriver {
+	return &FileDriver{
+		path:     path,
+		lifetime: time.Duration(minutes) * time.Minute,
+	}
+}
+
+func (f *FileDriver) Read(id string) (string, error) {
+	file := f.fullPath(id)
+	info, err := os.Stat(file)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", nil
+		}
+
+		return "", err
+	}
+	if info.ModTime().Add(f.lifetime).Before(time.Now()) {
+		return "", nil
+	}
+
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+func (f *FileDriver) Write(id string, data string) error {
+	if err := os.MkdirAll(f.path, 0755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(f.fullPath(id), []byte(data), 0600)
+}
+
+func (f *FileDriver) Destroy(id string) error {
+	if err := os.Remove(f.fullPath(id)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+func (f *FileDriver) Gc(maxLifetime int) error {
+	entries, err := os.ReadDir(f.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+
+		return err
+	}
+
+	expiredAt := time.Now().Add(-time.Duration(maxLifetime) * time.Second)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		if info.ModTime().Before(expiredAt) {
+			if err := os.Remove(filepath.Join(f.path, entry.Name())); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (f *FileDriver) fullPath(id string) string {
+	return filepath.Join(f.path, filepath.Base(id))
+}
@@ -0,0 +1,39 @@
+package session
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileDriver(t *testing.T) {
+	dir := t.TempDir()
+	driver := NewFileDriver(dir, 1)
+
+	data, err := driver.Read("goravel")
+	assert.Nil(t, err)
+	assert.Empty(t, data)
+
+	assert.Nil(t, driver.Write("goravel", "data"))
+	data, err = driver.Read("goravel")
+	assert.Nil(t, err)
+	assert.Equal(t, "data", data)
+
+	expiredAt := time.Now().Add(-2 * time.Minute)
+	assert.Nil(t, os.Chtimes(filepath.Join(dir, "goravel"), expiredAt, expiredAt))
+	data, err = driver.Read("goravel")
+	assert.Nil(t, err)
+	assert.Empty(t, data)
+
+	assert.Nil(t, driver.Write("framework", "data"))
+	assert.Nil(t, driver.Gc(60))
+	assert.NoFileExists(t, filepath.Join(dir, "goravel"))
+	assert.FileExists(t, filepath.Join(dir, "framework"))
+
+	assert.Nil(t, driver.Destroy("framework"))
+	assert.Nil(t, driver.Destroy("framework"))
+	assert.NoFileExists(t, filepath.Join(dir, "framework"))
+}
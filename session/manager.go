@@ -0,0 +1,82 @@
+package session
+
+import (
+	"fmt"
+	"sync"
+
+	sessioncontract "github.com/goravel/framework/contracts/session"
+	"github.com/goravel/framework/facades"
+)
+
+type Driver string
+
+const (
+	DriverFile     Driver = "file"
+	DriverCookie   Driver = "cookie"
+	DriverRedis    Driver = "redis"
+	DriverDatabase Driver = "database"
+	DriverCustom   Driver = "custom"
+)
+
+type Manager struct {
+	mu      sync.Mutex
+	drivers map[string]sessioncontract.Driver
+}
+
+func NewManager() *Manager {
+	return &Manager{drivers: make(map[string]sessioncontract.Driver)}
+}
+
+func (m *Manager) Driver(name ...string) (sessioncontract.Driver, error) {
+	driverName := facades.Config.GetString("session.driver")
+	if len(name) > 0 && name[0] != "" {
+		driverName = name[0]
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if driver, exist := m.drivers[driverName]; exist {
+		return driver, nil
+	}
+
+	driver, err := NewDriver(driverName)
+	if err != nil {
+		return nil, err
+	}
+	m.drivers[driverName] = driver
+
+	return driver, nil
+}
+
+func (m *Manager) BuildSession(driver sessioncontract.Driver, id ...string) sessioncontract.Session {
+	var sessionID string
+	if len(id) > 0 {
+		sessionID = id[0]
+	}
+
+	return NewSession(facades.Config.GetString("session.cookie", "goravel_session"), driver, sessionID)
+}
+
+func NewDriver(name string) (sessioncontract.Driver, error) {
+	lifetime := facades.Config.GetInt("session.lifetime", 120)
+	switch Driver(name) {
+	case DriverFile:
+		return NewFileDriver(facades.Config.GetString("session.files", "storage/framework/sessions"), lifetime), nil
+	case DriverCookie:
+		return NewCookieDriver(lifetime), nil
+	case DriverRedis:
+		return NewRedisDriver(facades.Config.GetString("session.connection"), lifetime)
+	case DriverDatabase:
+		return NewDatabaseDriver(facades.Config.GetString("session.connection"), facades.Config.GetString("session.table", "sessions"), lifetime), nil
+	case DriverCustom:
+		driver, ok := facades.Config.Get("session.via").(sessioncontract.Driver)
+		if !ok {
+			return nil, fmt.Errorf("[session] init custom driver fail: via must be session.Driver")
+		}
+
+		return driver, nil
+	}
+
+	return nil, fmt.Errorf("[session] invalid driver: %s, only support file, cookie, redis, database, custom", name)
+}
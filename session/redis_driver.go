@@ -0,0 +1,66 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+
+	"github.com/goravel/framework/facades"
+)
+
+// RedisDriver Store the sessions in the redis connection of database.redis, the sessions expire automatically.
+type RedisDriver struct {
+	prefix   string
+	lifetime time.Duration
+	redis    *redis.Client
+}
+
+func NewRedisDriver(connection string, minutes int) (*RedisDriver, error) {
+	if connection == "" {
+		connection = "default"
+	}
+
+	host := facades.Config.GetString("database.redis." + connection + ".host")
+	if host == "" {
+		return nil, errors.New("[session] the redis connection " + connection + " is not configured")
+	}
+
+	client := redis.NewClient(&redis.Options{
+		Addr:     host + ":" + facades.Config.GetString("database.redis."+connection+".port"),
+		Password: facades.Config.GetString("database.redis." + connection + ".password"),
+		DB:       facades.Config.GetInt("database.redis." + connection + ".database"),
+	})
+
+	if _, err := client.Ping(context.Background()).Result(); err != nil {
+		return nil, err
+	}
+
+	return &RedisDriver{
+		prefix:   facades.Config.GetString("session.cookie", "goravel_session") + ":",
+		lifetime: time.Duration(minutes) * time.Minute,
+		redis:    client,
+	}, nil
+}
+
+func (r *RedisDriver) Read(id string) (string, error) {
+	data, err := r.redis.Get(context.Background(), r.prefix+id).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", nil
+	}
+
+	return data, err
+}
+
+func (r *RedisDriver) Write(id string, data string) error {
+	return r.redis.Set(context.Background(), r.prefix+id, data, r.lifetime).Err()
+}
+
+func (r *RedisDriver) Destroy(id string) error {
+	return r.redis.Del(context.Background(), r.prefix+id).Err()
+}
+
+func (r *RedisDriver) Gc(maxLifetime int) error {
+	return nil
+}
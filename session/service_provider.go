@@ -0,0 +1,16 @@
+package session
+
+import (
+	"github.com/goravel/framework/facades"
+)
+
+type ServiceProvider struct {
+}
+
+func (session *ServiceProvider) Register() {
+	facades.Session = NewManager()
+}
+
+func (session *ServiceProvider) Boot() {
+
+}
@@ -0,0 +1,287 @@
+package session
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+
+	sessioncontract "github.com/goravel/framework/contracts/session"
+)
+
+const (
+	idLength = 40
+
+	tokenKey    = "_token"
+	flashNewKey = "_flash.new"
+	flashOldKey = "_flash.old"
+)
+
+// Session The data is serialized as JSON by the driver, so numbers are float64 after they are loaded.
+type Session struct {
+	id         string
+	name       string
+	attributes map[string]interface{}
+	driver     sessioncontract.Driver
+	started    bool
+}
+
+func NewSession(name string, driver sessioncontract.Driver, id string) sessioncontract.Session {
+	session := &Session{
+		name:       name,
+		attributes: make(map[string]interface{}),
+		driver:     driver,
+	}
+	session.SetID(id)
+
+	return session
+}
+
+func (s *Session) GetID() string {
+	return s.id
+}
+
+func (s *Session) SetID(id string) sessioncontract.Session {
+	if isValidID(id) {
+		s.id = id
+	} else {
+		s.id = generateID()
+	}
+
+	return s
+}
+
+func (s *Session) GetName() string {
+	return s.name
+}
+
+func (s *Session) Start() bool {
+	data, err := s.driver.Read(s.id)
+	if err == nil && data != "" {
+		var attributes map[string]interface{}
+		if err := json.Unmarshal([]byte(data), &attributes); err == nil {
+			for key, value := range attributes {
+				s.attributes[key] = value
+			}
+		}
+	}
+
+	if !s.Has(tokenKey) {
+		s.RegenerateToken()
+	}
+
+	s.started = true
+
+	return s.started
+}
+
+func (s *Session) Save() error {
+	s.ageFlashData()
+
+	data, err := json.Marshal(s.attributes)
+	if err != nil {
+		return err
+	}
+
+	s.started = false
+
+	return s.driver.Write(s.id, string(data))
+}
+
+func (s *Session) IsStarted() bool {
+	return s.started
+}
+
+func (s *Session) All() map[string]interface{} {
+	all := make(map[string]interface{}, len(s.attributes))
+	for key, value := range s.attributes {
+		all[key] = value
+	}
+
+	return all
+}
+
+func (s *Session) Exists(key string) bool {
+	_, exist := s.attributes[key]
+
+	return exist
+}
+
+func (s *Session) Has(key string) bool {
+	value, exist := s.attributes[key]
+
+	return exist && value != nil
+}
+
+func (s *Session) Get(key string, defaultValue ...interface{}) interface{} {
+	if value, exist := s.attributes[key]; exist {
+		return value
+	}
+	if len(defaultValue) > 0 {
+		return defaultValue[0]
+	}
+
+	return nil
+}
+
+func (s *Session) Put(key string, value interface{}) sessioncontract.Session {
+	s.attributes[key] = value
+
+	return s
+}
+
+func (s *Session) Pull(key string, defaultValue ...interface{}) interface{} {
+	value := s.Get(key, defaultValue...)
+	s.Forget(key)
+
+	return value
+}
+
+func (s *Session) Forget(keys ...string) sessioncontract.Session {
+	for _, key := range keys {
+		delete(s.attributes, key)
+	}
+
+	return s
+}
+
+func (s *Session) Flush() sessioncontract.Session {
+	s.attributes = make(map[string]interface{})
+
+	return s
+}
+
+func (s *Session) Flash(key string, value interface{}) sessioncontract.Session {
+	s.Put(key, value)
+	s.Put(flashNewKey, appendUnique(s.keys(flashNewKey), key))
+	s.Put(flashOldKey, remove(s.keys(flashOldKey), key))
+
+	return s
+}
+
+func (s *Session) Now(key string, value interface{}) sessioncontract.Session {
+	s.Put(key, value)
+	s.Put(flashOldKey, appendUnique(s.keys(flashOldKey), key))
+
+	return s
+}
+
+func (s *Session) Reflash() sessioncontract.Session {
+	s.Put(flashNewKey, appendUnique(s.keys(flashNewKey), s.keys(flashOldKey)...))
+	s.Put(flashOldKey, []string{})
+
+	return s
+}
+
+func (s *Session) Keep(keys ...string) sessioncontract.Session {
+	s.Put(flashNewKey, appendUnique(s.keys(flashNewKey), keys...))
+	s.Put(flashOldKey, remove(s.keys(flashOldKey), keys...))
+
+	return s
+}
+
+func (s *Session) Token() string {
+	token, _ := s.Get(tokenKey).(string)
+
+	return token
+}
+
+func (s *Session) RegenerateToken() sessioncontract.Session {
+	return s.Put(tokenKey, generateID())
+}
+
+func (s *Session) Regenerate(destroy ...bool) error {
+	if len(destroy) > 0 && destroy[0] {
+		if err := s.driver.Destroy(s.id); err != nil {
+			return err
+		}
+	}
+
+	s.id = generateID()
+
+	return nil
+}
+
+func (s *Session) Invalidate() error {
+	s.Flush()
+	s.RegenerateToken()
+
+	return s.Regenerate(true)
+}
+
+// ageFlashData Remove the flash data of the previous request, and age the flash data of the current request.
+func (s *Session) ageFlashData() {
+	s.Forget(s.keys(flashOldKey)...)
+	s.Put(flashOldKey, s.keys(flashNewKey))
+	s.Put(flashNewKey, []string{})
+}
+
+// keys Get a list of keys stored in the session, it may be []interface{} after it's loaded from the driver.
+func (s *Session) keys(key string) []string {
+	switch value := s.attributes[key].(type) {
+	case []string:
+		return value
+	case []interface{}:
+		keys := make([]string, 0, len(value))
+		for _, item := range value {
+			if itemAsString, ok := item.(string); ok {
+				keys = append(keys, itemAsString)
+			}
+		}
+
+		return keys
+	}
+
+	return []string{}
+}
+
+func appendUnique(keys []string, values ...string) []string {
+	result := append([]string{}, keys...)
+	for _, value := range values {
+		if !contains(result, value) {
+			result = append(result, value)
+		}
+	}
+
+	return result
+}
+
+func remove(keys []string, values ...string) []string {
+	result := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if !contains(values, key) {
+			result = append(result, key)
+		}
+	}
+
+	return result
+}
+
+func contains(keys []string, value string) bool {
+	for _, key := range keys {
+		if key == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// generateID Generate a random session ID via crypto/rand, it's hex encoded.
+func generateID() string {
+	bytes := make([]byte, idLength/2)
+	if _, err := rand.Read(bytes); err != nil {
+		panic(err)
+	}
+
+	return hex.EncodeToString(bytes)
+}
+
+func isValidID(id string) bool {
+	if len(id) != idLength {
+		return false
+	}
+
+	_, err := hex.DecodeString(id)
+
+	return err == nil
+}
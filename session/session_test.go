@@ -0,0 +1,96 @@
+package session
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSession(t *testing.T) {
+	driver := NewFileDriver(t.TempDir(), 120)
+
+	s := NewSession("goravel_session", driver, "invalid")
+	assert.Len(t, s.GetID(), idLength)
+	assert.NotEqual(t, "invalid", s.GetID())
+	assert.Equal(t, "goravel_session", s.GetName())
+	assert.True(t, s.Start())
+	assert.True(t, s.IsStarted())
+
+	s.Put("name", "goravel").Put("nil", nil)
+	assert.Equal(t, "goravel", s.Get("name"))
+	assert.Equal(t, "default", s.Get("missing", "default"))
+	assert.True(t, s.Has("name"))
+	assert.False(t, s.Has("nil"))
+	assert.True(t, s.Exists("nil"))
+	assert.Nil(t, s.Save())
+
+	id := s.GetID()
+	s = NewSession("goravel_session", driver, id)
+	assert.Equal(t, id, s.GetID())
+	s.Start()
+	assert.Equal(t, "goravel", s.Pull("name"))
+	assert.False(t, s.Exists("name"))
+
+	assert.Nil(t, s.Regenerate(true))
+	assert.NotEqual(t, id, s.GetID())
+	data, err := driver.Read(id)
+	assert.Nil(t, err)
+	assert.Empty(t, data)
+
+	token := s.Token()
+	assert.Len(t, token, idLength)
+	s.Put("name", "goravel")
+	assert.Nil(t, s.Invalidate())
+	assert.False(t, s.Has("name"))
+	assert.NotEqual(t, token, s.Token())
+}
+
+func TestFlash(t *testing.T) {
+	driver := NewFileDriver(t.TempDir(), 120)
+
+	// The first request flashes the data.
+	s := NewSession("goravel_session", driver, "")
+	s.Start()
+	s.Flash("status", "created")
+	s.Now("now", "current request")
+	assert.Equal(t, "created", s.Get("status"))
+	assert.Equal(t, "current request", s.Get("now"))
+	assert.Nil(t, s.Save())
+
+	// The next request reads the flash data.
+	id := s.GetID()
+	s = NewSession("goravel_session", driver, id)
+	s.Start()
+	assert.Equal(t, "created", s.Get("status"))
+	assert.False(t, s.Has("now"))
+	assert.Nil(t, s.Save())
+
+	// The flash data is removed after the next request.
+	s = NewSession("goravel_session", driver, id)
+	s.Start()
+	s.Flash("status", "updated")
+	s.Flash("message", "goravel")
+	assert.Nil(t, s.Save())
+
+	// Keep and Reflash the flash data for an additional request.
+	s = NewSession("goravel_session", driver, id)
+	s.Start()
+	s.Keep("status")
+	assert.Nil(t, s.Save())
+
+	s = NewSession("goravel_session", driver, id)
+	s.Start()
+	assert.Equal(t, "updated", s.Get("status"))
+	assert.False(t, s.Has("message"))
+	s.Reflash()
+	assert.Nil(t, s.Save())
+
+	s = NewSession("goravel_session", driver, id)
+	s.Start()
+	assert.Equal(t, "updated", s.Get("status"))
+	assert.Nil(t, s.Save())
+
+	s = NewSession("goravel_session", driver, id)
+	s.Start()
+	assert.False(t, s.Has("status"))
+}
@@ -0,0 +1,81 @@
+package crypt
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"io"
+)
+
+var (
+	ErrorEmptyKey       = errors.New("the encryption key is required, please run `go run . artisan key:generate`")
+	ErrorInvalidPayload = errors.New("the payload is invalid")
+)
+
+// Encrypt Encrypt the value with AES-256-GCM, the result is URL safe base64 encoded.
+func Encrypt(key string, value []byte) (string, error) {
+	aead, err := newAead(key)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(aead.Seal(nonce, nonce, value, nil)), nil
+}
+
+// Decrypt Decrypt the payload generated by Encrypt.
+func Decrypt(key, payload string) ([]byte, error) {
+	aead, err := newAead(key)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil || len(data) < aead.NonceSize() {
+		return nil, ErrorInvalidPayload
+	}
+
+	value, err := aead.Open(nil, data[:aead.NonceSize()], data[aead.NonceSize():], nil)
+	if err != nil {
+		return nil, ErrorInvalidPayload
+	}
+
+	return value, nil
+}
+
+// Sign Generate the HMAC-SHA256 signature of the value, the result is hex encoded.
+func Sign(key string, value []byte) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write(value)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify Determine if the signature of the value is valid, it's safe against timing attacks.
+func Verify(key string, value []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(key, value)), []byte(signature))
+}
+
+func newAead(key string) (cipher.AEAD, error) {
+	if key == "" {
+		return nil, ErrorEmptyKey
+	}
+
+	// The key is hashed to satisfy the key size of AES-256, whatever the length of app.key is.
+	hash := sha256.Sum256([]byte(key))
+	block, err := aes.NewCipher(hash[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,32 @@
+package crypt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncryptAndDecrypt(t *testing.T) {
+	payload, err := Encrypt("12345678901234567890123456789012", []byte("goravel"))
+	assert.Nil(t, err)
+
+	value, err := Decrypt("12345678901234567890123456789012", payload)
+	assert.Nil(t, err)
+	assert.Equal(t, "goravel", string(value))
+
+	_, err = Decrypt("abcdefghijklmnopqrstuvwxyzabcdef", payload)
+	assert.ErrorIs(t, err, ErrorInvalidPayload)
+
+	_, err = Decrypt("12345678901234567890123456789012", "goravel")
+	assert.ErrorIs(t, err, ErrorInvalidPayload)
+
+	_, err = Encrypt("", []byte("goravel"))
+	assert.ErrorIs(t, err, ErrorEmptyKey)
+}
+
+func TestSignAndVerify(t *testing.T) {
+	signature := Sign("key", []byte("goravel"))
+	assert.True(t, Verify("key", []byte("goravel"), signature))
+	assert.False(t, Verify("key", []byte("goravel!"), signature))
+	assert.False(t, Verify("other", []byte("goravel"), signature))
+}
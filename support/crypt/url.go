@@ -0,0 +1,75 @@
+package crypt
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrorInvalidSignature = errors.New("the signature of the url is invalid")
+	ErrorExpiredSignature = errors.New("the signature of the url has expired")
+)
+
+// SignUrl Append the signature of the url to its query string, the url expires at the expiration unless it's zero.
+// The host is signed if the url has one, so it can only be served by the host: //acme.goravel.dev/unsubscribe?signature=xxx
+// Otherwise the path and the query string are signed only, so the url can be served by any host: /unsubscribe?signature=xxx
+func SignUrl(key, rawUrl string, expiration time.Time) (string, error) {
+	if key == "" {
+		return "", ErrorEmptyKey
+	}
+
+	u, err := url.Parse(rawUrl)
+	if err != nil {
+		return "", err
+	}
+
+	query := u.Query()
+	query.Del("signature")
+	query.Del("expires")
+	if !expiration.IsZero() {
+		query.Set("expires", strconv.FormatInt(expiration.Unix(), 10))
+	}
+	query.Set("signature", Sign(key, signedPayload(u, query)))
+	u.RawQuery = query.Encode()
+
+	return u.String(), nil
+}
+
+// VerifyUrl Verify the signature of the url generated by SignUrl, and check if it has expired.
+// The host of the url should be set if it's signed with the host, the Host of the request for example.
+func VerifyUrl(key string, u *url.URL) error {
+	query := u.Query()
+	signature := query.Get("signature")
+	query.Del("signature")
+	if key == "" || signature == "" || !Verify(key, []byte(signedPayload(u, query)), signature) {
+		return ErrorInvalidSignature
+	}
+
+	if expires := query.Get("expires"); expires != "" {
+		timestamp, err := strconv.ParseInt(expires, 10, 64)
+		if err != nil {
+			return ErrorInvalidSignature
+		}
+		if time.Now().Unix() > timestamp {
+			return ErrorExpiredSignature
+		}
+	}
+
+	return nil
+}
+
+func signedPayload(u *url.URL, query url.Values) []byte {
+	payload := u.EscapedPath()
+	if u.Host != "" {
+		// The port is ignored, the host may be served behind a proxy.
+		payload = "//" + strings.ToLower(u.Hostname()) + payload
+	}
+	if len(query) > 0 {
+		payload += "?" + query.Encode()
+	}
+
+	return []byte(payload)
+}
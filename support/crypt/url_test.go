@@ -0,0 +1,50 @@
+package crypt
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignUrlAndVerifyUrl(t *testing.T) {
+	key := "12345678901234567890123456789012"
+
+	signed, err := SignUrl(key, "https://goravel.dev/storage/report.pdf?download=1", time.Time{})
+	assert.Nil(t, err)
+	u, err := url.Parse(signed)
+	assert.Nil(t, err)
+	assert.Equal(t, "goravel.dev", u.Host)
+	assert.Nil(t, VerifyUrl(key, u))
+	assert.ErrorIs(t, VerifyUrl("abcdefghijklmnopqrstuvwxyzabcdef", u), ErrorInvalidSignature)
+
+	// The host is signed, the url can't be served by another host, the port is ignored.
+	u, _ = url.Parse("//GORAVEL.dev:8080/storage/report.pdf?" + u.RawQuery)
+	assert.Nil(t, VerifyUrl(key, u))
+	u.Host = "other.dev"
+	assert.ErrorIs(t, VerifyUrl(key, u), ErrorInvalidSignature)
+	u.Host = ""
+	assert.ErrorIs(t, VerifyUrl(key, u), ErrorInvalidSignature)
+
+	u, _ = url.Parse(signed + "&download=2")
+	assert.ErrorIs(t, VerifyUrl(key, u), ErrorInvalidSignature)
+
+	temporary, err := SignUrl(key, "/storage/report.pdf", time.Now().Add(time.Minute))
+	assert.Nil(t, err)
+	u, _ = url.Parse(temporary)
+	assert.Nil(t, VerifyUrl(key, u))
+
+	query := u.Query()
+	query.Set("expires", "9999999999")
+	u.RawQuery = query.Encode()
+	assert.ErrorIs(t, VerifyUrl(key, u), ErrorInvalidSignature)
+
+	expired, err := SignUrl(key, "/storage/report.pdf", time.Now().Add(-time.Minute))
+	assert.Nil(t, err)
+	u, _ = url.Parse(expired)
+	assert.ErrorIs(t, VerifyUrl(key, u), ErrorExpiredSignature)
+
+	_, err = SignUrl("", "/storage/report.pdf", time.Time{})
+	assert.ErrorIs(t, err, ErrorEmptyKey)
+}
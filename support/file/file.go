@@ -3,6 +3,7 @@ package file
 import (
 	"errors"
 	"io/ioutil"
+	"net/http"
 	"os"
 	"path"
 	"strings"
@@ -114,3 +115,45 @@ func Extension(file string, originalWhenUnknown ...bool) (string, error) {
 func ClientOriginalExtension(file string) string {
 	return strings.ReplaceAll(path.Ext(file), ".", "")
 }
+
+// MimeType Get the MIME type sniffed from the content of the file, the Content-Type sent by the client isn't trusted.
+func MimeType(file string) (string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	head := make([]byte, 512)
+	n, err := f.Read(head)
+	if err != nil && n == 0 {
+		return "", err
+	}
+
+	return MimeTypeOf(head[:n]), nil
+}
+
+// MimeTypeOf Get the MIME type of the head of a content, the types unknown by filetype are detected by http.DetectContentType.
+func MimeTypeOf(head []byte) string {
+	if kind, err := filetype.Match(head); err == nil && kind != filetype.Unknown {
+		return kind.MIME.Value
+	}
+
+	mimeType, _, _ := strings.Cut(http.DetectContentType(head), ";")
+
+	return mimeType
+}
+
+// MimeTypeAllowed Determine if the MIME type matches one of the patterns, the subtype of a pattern can be *: image/*
+func MimeTypeAllowed(mimeType string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if strings.EqualFold(pattern, mimeType) {
+			return true
+		}
+		if strings.HasSuffix(pattern, "/*") && strings.HasPrefix(strings.ToLower(mimeType), strings.ToLower(strings.TrimSuffix(pattern, "*"))) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -32,3 +32,23 @@ func TestExtension(t *testing.T) {
 func TestClientOriginalExtension(t *testing.T) {
 	assert.Equal(t, ClientOriginalExtension("logo.png"), "png")
 }
+
+func TestMimeType(t *testing.T) {
+	mimeType, err := MimeType("file.go")
+	assert.Nil(t, err)
+	assert.Equal(t, "text/plain", mimeType)
+
+	_, err = MimeType("missing.go")
+	assert.NotNil(t, err)
+
+	assert.Equal(t, "image/png", MimeTypeOf([]byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")))
+	assert.Equal(t, "application/pdf", MimeTypeOf([]byte("%PDF-1.4")))
+	assert.Equal(t, "application/octet-stream", MimeTypeOf([]byte{0x00, 0x01, 0x02}))
+}
+
+func TestMimeTypeAllowed(t *testing.T) {
+	assert.True(t, MimeTypeAllowed("image/png", []string{"image/*"}))
+	assert.True(t, MimeTypeAllowed("application/pdf", []string{"image/png", "Application/PDF"}))
+	assert.False(t, MimeTypeAllowed("application/pdf", []string{"image/*"}))
+	assert.False(t, MimeTypeAllowed("image/png", nil))
+}
@@ -0,0 +1,186 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	nethttp "net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/contracts/route"
+	"github.com/goravel/framework/facades"
+	"github.com/goravel/framework/http"
+)
+
+// TestRequest Send requests to the engine in-process, no socket is listened.
+type TestRequest struct {
+	t       *testing.T
+	engine  route.Engine
+	headers nethttp.Header
+	cookies []*nethttp.Cookie
+}
+
+// NewTestRequest The facades.Route is used if the engine is empty.
+func NewTestRequest(t *testing.T, engine ...route.Engine) *TestRequest {
+	request := &TestRequest{
+		t:       t,
+		engine:  facades.Route,
+		headers: make(nethttp.Header),
+	}
+	if len(engine) > 0 {
+		request.engine = engine[0]
+	}
+
+	return request
+}
+
+func (r *TestRequest) WithHeader(key, value string) *TestRequest {
+	r.headers.Set(key, value)
+
+	return r
+}
+
+func (r *TestRequest) WithHeaders(headers map[string]string) *TestRequest {
+	for key, value := range headers {
+		r.headers.Set(key, value)
+	}
+
+	return r
+}
+
+// WithToken Set the Authorization header, the type is Bearer by default.
+func (r *TestRequest) WithToken(token string, tokenType ...string) *TestRequest {
+	typ := "Bearer"
+	if len(tokenType) > 0 {
+		typ = tokenType[0]
+	}
+
+	return r.WithHeader("Authorization", typ+" "+token)
+}
+
+// WithCookie Add a cookie encrypted with app.key, the same as the cookies set by Response.Cookie.
+func (r *TestRequest) WithCookie(name, value string) *TestRequest {
+	encrypted, err := http.EncryptCookie(name, value)
+	if !assert.Nil(r.t, err) {
+		return r
+	}
+
+	return r.WithUnencryptedCookie(name, encrypted)
+}
+
+// WithUnencryptedCookie Add a cookie as it is, it's used for the cookies in the cookie.except config.
+func (r *TestRequest) WithUnencryptedCookie(name, value string) *TestRequest {
+	r.cookies = append(r.cookies, &nethttp.Cookie{Name: name, Value: value})
+
+	return r
+}
+
+// WithSession Store the data in a new session of the session.driver, the session is loaded by the StartSession middleware.
+func (r *TestRequest) WithSession(data map[string]interface{}) *TestRequest {
+	driver, err := facades.Session.Driver()
+	if !assert.Nil(r.t, err) {
+		return r
+	}
+
+	session := facades.Session.BuildSession(driver)
+	session.Start()
+	for key, value := range data {
+		session.Put(key, value)
+	}
+	if !assert.Nil(r.t, session.Save()) {
+		return r
+	}
+
+	return r.WithUnencryptedCookie(session.GetName(), session.GetID())
+}
+
+// ActingAs Login the user via the guard, the guard is the auth.defaults.guard config if it's empty.
+func (r *TestRequest) ActingAs(user interface{}, guard ...string) *TestRequest {
+	auth := facades.Auth
+	if len(guard) > 0 {
+		auth = auth.Guard(guard[0])
+	}
+
+	ctx := http.NewNetHttpContext(httptest.NewRecorder(), httptest.NewRequest(nethttp.MethodGet, "/", nil), nil, nil)
+	token, err := auth.Login(ctx, user)
+	if !assert.Nil(r.t, err) {
+		return r
+	}
+
+	return r.WithToken(token)
+}
+
+func (r *TestRequest) Get(uri string) *TestResponse {
+	return r.Call(nethttp.MethodGet, uri, nil)
+}
+
+func (r *TestRequest) Post(uri string, body io.Reader) *TestResponse {
+	return r.Call(nethttp.MethodPost, uri, body)
+}
+
+func (r *TestRequest) Put(uri string, body io.Reader) *TestResponse {
+	return r.Call(nethttp.MethodPut, uri, body)
+}
+
+func (r *TestRequest) Patch(uri string, body io.Reader) *TestResponse {
+	return r.Call(nethttp.MethodPatch, uri, body)
+}
+
+func (r *TestRequest) Delete(uri string, body io.Reader) *TestResponse {
+	return r.Call(nethttp.MethodDelete, uri, body)
+}
+
+// Form Send the data as application/x-www-form-urlencoded.
+func (r *TestRequest) Form(method, uri string, data map[string]string) *TestResponse {
+	values := make(url.Values)
+	for key, value := range data {
+		values.Set(key, value)
+	}
+
+	return r.call(method, uri, strings.NewReader(values.Encode()), map[string]string{
+		"Content-Type": "application/x-www-form-urlencoded",
+	})
+}
+
+// Json Send the data encoded as JSON, and accept JSON. The test is stopped if the data can't be encoded.
+func (r *TestRequest) Json(method, uri string, data interface{}) *TestResponse {
+	var body io.Reader
+	if data != nil {
+		content, err := json.Marshal(data)
+		if err != nil {
+			r.t.Fatalf("The data can't be encoded as JSON: %v", err)
+		}
+		body = bytes.NewReader(content)
+	}
+
+	return r.call(method, uri, body, map[string]string{
+		"Content-Type": "application/json",
+		"Accept":       "application/json",
+	})
+}
+
+func (r *TestRequest) Call(method, uri string, body io.Reader) *TestResponse {
+	return r.call(method, uri, body, nil)
+}
+
+// call The headers are only added to this request, they override the headers set by WithHeader.
+func (r *TestRequest) call(method, uri string, body io.Reader, headers map[string]string) *TestResponse {
+	request := httptest.NewRequest(method, uri, body)
+	request.Header = r.headers.Clone()
+	for key, value := range headers {
+		request.Header.Set(key, value)
+	}
+	for _, cookie := range r.cookies {
+		request.AddCookie(cookie)
+	}
+
+	recorder := httptest.NewRecorder()
+	r.engine.ServeHTTP(recorder, request)
+
+	return NewTestResponse(r.t, recorder.Result())
+}
@@ -0,0 +1,116 @@
+package http
+
+import (
+	nethttp "net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	authmocks "github.com/goravel/framework/contracts/auth/mocks"
+	routemocks "github.com/goravel/framework/contracts/route/mocks"
+	sessionmocks "github.com/goravel/framework/contracts/session/mocks"
+	"github.com/goravel/framework/facades"
+	"github.com/goravel/framework/http"
+	"github.com/goravel/framework/session"
+	testingmock "github.com/goravel/framework/testing/mock"
+)
+
+type engine struct {
+	routemocks.Engine
+	handler nethttp.HandlerFunc
+}
+
+func (e *engine) ServeHTTP(w nethttp.ResponseWriter, req *nethttp.Request) {
+	e.handler(w, req)
+}
+
+func TestTestRequest(t *testing.T) {
+	mockConfig := testingmock.Config()
+	mockConfig.On("GetString", "app.key").Return("12345678901234567890123456789012")
+
+	mockAuth := &authmocks.Auth{}
+	facades.Auth = mockAuth
+	mockAuth.On("Guard", "admin").Return(mockAuth).Once()
+	mockAuth.On("Login", mock.Anything, "goravel").Return("token", nil).Once()
+
+	e := &engine{handler: func(w nethttp.ResponseWriter, req *nethttp.Request) {
+		cookie, err := req.Cookie("name")
+		assert.Nil(t, err)
+		value, err := http.DecryptCookie("name", cookie.Value)
+		assert.Nil(t, err)
+
+		encrypted, err := http.EncryptCookie("name", value)
+		assert.Nil(t, err)
+		nethttp.SetCookie(w, &nethttp.Cookie{Name: "name", Value: encrypted})
+		nethttp.SetCookie(w, &nethttp.Cookie{Name: "plain", Value: "goravel"})
+		nethttp.SetCookie(w, &nethttp.Cookie{Name: "expired", MaxAge: -1})
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Authorization", req.Header.Get("Authorization"))
+		w.Header().Set("X-Method", req.Method)
+		w.WriteHeader(nethttp.StatusCreated)
+		_, _ = w.Write([]byte(`{"data":[{"id":1,"name":"goravel"}],"meta":{"total":1}}`))
+	}}
+
+	NewTestRequest(t, e).
+		ActingAs("goravel", "admin").
+		WithCookie("name", "goravel").
+		Json(nethttp.MethodPost, "/users", map[string]interface{}{"name": "goravel"}).
+		AssertCreated().
+		AssertHeader("X-Authorization", "Bearer token").
+		AssertHeader("X-Method", nethttp.MethodPost).
+		AssertHeaderMissing("X-Missing").
+		AssertCookie("name", "goravel").
+		AssertPlainCookie("plain", "goravel").
+		AssertCookieExpired("expired").
+		AssertCookieMissing("missing").
+		AssertSee("goravel").
+		AssertJson(map[string]interface{}{
+			"data.0.id":  1,
+			"meta.total": 1,
+		}).
+		AssertJsonPath("data.0", map[string]interface{}{"id": 1, "name": "goravel"}).
+		AssertJsonMissingPath("data.1").
+		AssertExactJson(map[string]interface{}{
+			"data": []map[string]interface{}{{"id": 1, "name": "goravel"}},
+			"meta": map[string]interface{}{"total": 1},
+		})
+
+	mockAuth.AssertExpectations(t)
+}
+
+func TestHeadersOfRequest(t *testing.T) {
+	e := &engine{handler: func(w nethttp.ResponseWriter, req *nethttp.Request) {
+		_, _ = w.Write([]byte(req.Header.Get("Content-Type") + "|" + req.Header.Get("Accept") + "|" + req.Header.Get("X-Id")))
+	}}
+
+	request := NewTestRequest(t, e).WithHeader("X-Id", "1")
+	assert.Equal(t, "application/json|application/json|1", request.Json(nethttp.MethodPost, "/", nil).Content())
+	assert.Equal(t, "application/x-www-form-urlencoded||1", request.Form(nethttp.MethodPost, "/", map[string]string{"name": "goravel"}).Content())
+	assert.Equal(t, "||1", request.Get("/").Content())
+}
+
+func TestWithSession(t *testing.T) {
+	driver := session.NewFileDriver(t.TempDir(), 120)
+	mockManager := &sessionmocks.Manager{}
+	facades.Session = mockManager
+	mockManager.On("Driver").Return(driver, nil).Once()
+	mockManager.On("BuildSession", driver).Return(session.NewSession("goravel_session", driver, "")).Once()
+
+	e := &engine{handler: func(w nethttp.ResponseWriter, req *nethttp.Request) {
+		cookie, err := req.Cookie("goravel_session")
+		assert.Nil(t, err)
+
+		s := session.NewSession("goravel_session", driver, cookie.Value)
+		s.Start()
+		_, _ = w.Write([]byte(s.Get("name").(string)))
+	}}
+
+	NewTestRequest(t, e).
+		WithSession(map[string]interface{}{"name": "goravel"}).
+		Get("/").
+		AssertOk().
+		AssertSee("goravel")
+
+	mockManager.AssertExpectations(t)
+}
@@ -0,0 +1,270 @@
+package http
+
+import (
+	"encoding/json"
+	"io"
+	nethttp "net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goravel/framework/http"
+)
+
+type TestResponse struct {
+	t        *testing.T
+	response *nethttp.Response
+	content  []byte
+}
+
+func NewTestResponse(t *testing.T, response *nethttp.Response) *TestResponse {
+	defer response.Body.Close()
+
+	content, err := io.ReadAll(response.Body)
+	assert.Nil(t, err)
+
+	return &TestResponse{
+		t:        t,
+		response: response,
+		content:  content,
+	}
+}
+
+func (r *TestResponse) Status() int {
+	return r.response.StatusCode
+}
+
+func (r *TestResponse) Headers() nethttp.Header {
+	return r.response.Header
+}
+
+func (r *TestResponse) Cookies() []*nethttp.Cookie {
+	return r.response.Cookies()
+}
+
+func (r *TestResponse) Content() string {
+	return string(r.content)
+}
+
+// Json Decode the content as JSON, numbers are float64.
+func (r *TestResponse) Json() (map[string]interface{}, error) {
+	var data map[string]interface{}
+	if err := json.Unmarshal(r.content, &data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+func (r *TestResponse) AssertStatus(status int) *TestResponse {
+	assert.Equal(r.t, status, r.Status(), "Expected response status code [%d] but received %d: %s", status, r.Status(), r.Content())
+
+	return r
+}
+
+func (r *TestResponse) AssertOk() *TestResponse {
+	return r.AssertStatus(nethttp.StatusOK)
+}
+
+func (r *TestResponse) AssertCreated() *TestResponse {
+	return r.AssertStatus(nethttp.StatusCreated)
+}
+
+func (r *TestResponse) AssertNoContent() *TestResponse {
+	return r.AssertStatus(nethttp.StatusNoContent)
+}
+
+func (r *TestResponse) AssertUnauthorized() *TestResponse {
+	return r.AssertStatus(nethttp.StatusUnauthorized)
+}
+
+func (r *TestResponse) AssertForbidden() *TestResponse {
+	return r.AssertStatus(nethttp.StatusForbidden)
+}
+
+func (r *TestResponse) AssertNotFound() *TestResponse {
+	return r.AssertStatus(nethttp.StatusNotFound)
+}
+
+func (r *TestResponse) AssertUnprocessableEntity() *TestResponse {
+	return r.AssertStatus(nethttp.StatusUnprocessableEntity)
+}
+
+// AssertRedirect Assert the response is a redirect, and the location is the uri if it's given.
+func (r *TestResponse) AssertRedirect(uri ...string) *TestResponse {
+	assert.True(r.t, r.Status() >= 300 && r.Status() < 400, "Expected response status code [3xx] but received %d", r.Status())
+	if len(uri) > 0 {
+		r.AssertHeader("Location", uri[0])
+	}
+
+	return r
+}
+
+// AssertHeader Assert the header exists, and the value is equal if it's given.
+func (r *TestResponse) AssertHeader(key string, value ...string) *TestResponse {
+	values, exist := r.response.Header[nethttp.CanonicalHeaderKey(key)]
+	if !assert.True(r.t, exist, "Header [%s] not present on response", key) {
+		return r
+	}
+	if len(value) > 0 {
+		assert.Equal(r.t, value[0], values[0], "Header [%s] was found, but value [%s] does not match [%s]", key, values[0], value[0])
+	}
+
+	return r
+}
+
+func (r *TestResponse) AssertHeaderMissing(key string) *TestResponse {
+	_, exist := r.response.Header[nethttp.CanonicalHeaderKey(key)]
+	assert.False(r.t, exist, "Unexpected header [%s] is present on response", key)
+
+	return r
+}
+
+// AssertCookie Assert the cookie exists, and the decrypted value is equal if it's given.
+func (r *TestResponse) AssertCookie(name string, value ...string) *TestResponse {
+	cookie := r.cookie(name)
+	if !assert.NotNil(r.t, cookie, "Cookie [%s] not present on response", name) || len(value) == 0 {
+		return r
+	}
+
+	actual, err := http.DecryptCookie(name, cookie.Value)
+	if assert.Nil(r.t, err, "Cookie [%s] can't be decrypted", name) {
+		assert.Equal(r.t, value[0], actual, "Cookie [%s] was found, but value [%s] does not match [%s]", name, actual, value[0])
+	}
+
+	return r
+}
+
+// AssertPlainCookie Assert the cookie exists, and the unencrypted value is equal if it's given.
+func (r *TestResponse) AssertPlainCookie(name string, value ...string) *TestResponse {
+	cookie := r.cookie(name)
+	if assert.NotNil(r.t, cookie, "Cookie [%s] not present on response", name) && len(value) > 0 {
+		assert.Equal(r.t, value[0], cookie.Value, "Cookie [%s] was found, but value [%s] does not match [%s]", name, cookie.Value, value[0])
+	}
+
+	return r
+}
+
+func (r *TestResponse) AssertCookieExpired(name string) *TestResponse {
+	cookie := r.cookie(name)
+	if assert.NotNil(r.t, cookie, "Cookie [%s] not present on response", name) {
+		expired := cookie.MaxAge < 0 || (!cookie.Expires.IsZero() && cookie.Expires.Before(time.Now()))
+		assert.True(r.t, expired, "Cookie [%s] is not expired", name)
+	}
+
+	return r
+}
+
+func (r *TestResponse) AssertCookieMissing(name string) *TestResponse {
+	assert.Nil(r.t, r.cookie(name), "Cookie [%s] is present on response", name)
+
+	return r
+}
+
+// AssertSee Assert the content contains the value.
+func (r *TestResponse) AssertSee(value string) *TestResponse {
+	assert.Contains(r.t, r.Content(), value)
+
+	return r
+}
+
+func (r *TestResponse) AssertDontSee(value string) *TestResponse {
+	assert.NotContains(r.t, r.Content(), value)
+
+	return r
+}
+
+// AssertJson Assert the content contains the JSON fragment, the keys are paths: {"data.0.name": "goravel"}
+func (r *TestResponse) AssertJson(expected map[string]interface{}) *TestResponse {
+	for path, value := range expected {
+		r.AssertJsonPath(path, value)
+	}
+
+	return r
+}
+
+// AssertExactJson Assert the content is equal to the JSON.
+func (r *TestResponse) AssertExactJson(expected interface{}) *TestResponse {
+	var actual interface{}
+	if assert.Nil(r.t, json.Unmarshal(r.content, &actual), "Invalid JSON was returned: %s", r.Content()) {
+		assert.Equal(r.t, normalize(r.t, expected), actual)
+	}
+
+	return r
+}
+
+// AssertJsonPath Assert the value at the path is equal, the path is separated by dots: data.0.name
+func (r *TestResponse) AssertJsonPath(path string, expected interface{}) *TestResponse {
+	actual, exist := r.jsonPath(path)
+	if assert.True(r.t, exist, "Path [%s] not present in JSON: %s", path, r.Content()) {
+		assert.Equal(r.t, normalize(r.t, expected), actual, "Path [%s] does not match", path)
+	}
+
+	return r
+}
+
+func (r *TestResponse) AssertJsonMissingPath(path string) *TestResponse {
+	_, exist := r.jsonPath(path)
+	assert.False(r.t, exist, "Path [%s] is present in JSON: %s", path, r.Content())
+
+	return r
+}
+
+func (r *TestResponse) cookie(name string) *nethttp.Cookie {
+	var found *nethttp.Cookie
+	// The last one wins if the cookie is set multiple times.
+	for _, cookie := range r.Cookies() {
+		if cookie.Name == name {
+			found = cookie
+		}
+	}
+
+	return found
+}
+
+func (r *TestResponse) jsonPath(path string) (interface{}, bool) {
+	var current interface{}
+	if err := json.Unmarshal(r.content, &current); err != nil {
+		return nil, false
+	}
+	if path == "" {
+		return current, true
+	}
+
+	for _, segment := range strings.Split(path, ".") {
+		switch value := current.(type) {
+		case map[string]interface{}:
+			item, exist := value[segment]
+			if !exist {
+				return nil, false
+			}
+			current = item
+		case []interface{}:
+			index, err := strconv.Atoi(segment)
+			if err != nil || index < 0 || index >= len(value) {
+				return nil, false
+			}
+			current = value[index]
+		default:
+			return nil, false
+		}
+	}
+
+	return current, true
+}
+
+// normalize Convert the value to the types of decoded JSON, so 1 is equal to float64(1).
+func normalize(t *testing.T, value interface{}) interface{} {
+	content, err := json.Marshal(value)
+	if !assert.Nil(t, err) {
+		return value
+	}
+
+	var normalized interface{}
+	assert.Nil(t, json.Unmarshal(content, &normalized))
+
+	return normalized
+}
@@ -7,8 +7,12 @@ import (
 	ormmocks "github.com/goravel/framework/contracts/database/orm/mocks"
 	eventmocks "github.com/goravel/framework/contracts/event/mocks"
 	filesystemmocks "github.com/goravel/framework/contracts/filesystem/mocks"
+	httpmocks "github.com/goravel/framework/contracts/http/mocks"
+	httpclientmocks "github.com/goravel/framework/contracts/httpclient/mocks"
 	mailmocks "github.com/goravel/framework/contracts/mail/mocks"
 	queuemocks "github.com/goravel/framework/contracts/queue/mocks"
+	validationmocks "github.com/goravel/framework/contracts/validation/mocks"
+	viewmocks "github.com/goravel/framework/contracts/view/mocks"
 	"github.com/goravel/framework/facades"
 	"github.com/goravel/framework/log"
 )
@@ -48,6 +52,13 @@ func Event() (*eventmocks.Instance, *eventmocks.Task) {
 	return mockEvent, &eventmocks.Task{}
 }
 
+func Http() (*httpclientmocks.Http, *httpclientmocks.Request, *httpclientmocks.Response) {
+	mockHttp := &httpclientmocks.Http{}
+	facades.Http = mockHttp
+
+	return mockHttp, &httpclientmocks.Request{}, &httpclientmocks.Response{}
+}
+
 func Log() {
 	facades.Log = log.NewLogrus(nil, log.NewTestWriter())
 }
@@ -74,3 +85,24 @@ func Storage() (*filesystemmocks.Storage, *filesystemmocks.Driver, *filesystemmo
 
 	return mockStorage, mockDriver, mockFile
 }
+
+func Validation() (*validationmocks.Validation, *validationmocks.Validator, *validationmocks.Errors) {
+	mockValidation := &validationmocks.Validation{}
+	facades.Validation = mockValidation
+
+	return mockValidation, &validationmocks.Validator{}, &validationmocks.Errors{}
+}
+
+func RateLimiter() *httpmocks.RateLimiter {
+	mockRateLimiter := &httpmocks.RateLimiter{}
+	facades.RateLimiter = mockRateLimiter
+
+	return mockRateLimiter
+}
+
+func View() *viewmocks.View {
+	mockView := &viewmocks.View{}
+	facades.View = mockView
+
+	return mockView
+}
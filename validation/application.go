@@ -0,0 +1,83 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	validationcontract "github.com/goravel/framework/contracts/validation"
+)
+
+type Application struct {
+	mu    sync.RWMutex
+	rules []validationcontract.Rule
+}
+
+func NewApplication() *Application {
+	return &Application{}
+}
+
+func (app *Application) Make(data interface{}, rules map[string]string, options ...validationcontract.Option) (validationcontract.Validator, error) {
+	if data == nil {
+		return nil, errors.New("data can't be empty")
+	}
+	if len(rules) == 0 {
+		return nil, errors.New("rules can't be empty")
+	}
+
+	dataMap, err := dataToMap(data)
+	if err != nil {
+		return nil, err
+	}
+
+	realOptions := &validationcontract.Options{}
+	for _, option := range options {
+		option(realOptions)
+	}
+
+	validator := NewValidator(dataMap, realOptions, app.customRules())
+	if err := validator.validate(rules); err != nil {
+		return nil, err
+	}
+
+	return validator, nil
+}
+
+func (app *Application) AddRules(rules []validationcontract.Rule) error {
+	app.mu.Lock()
+	defer app.mu.Unlock()
+
+	for _, rule := range rules {
+		if _, exist := builtinRules[rule.Signature()]; exist {
+			return fmt.Errorf("duplicate rule name: %s", rule.Signature())
+		}
+		for _, existRule := range app.rules {
+			if existRule.Signature() == rule.Signature() {
+				return fmt.Errorf("duplicate rule name: %s", rule.Signature())
+			}
+		}
+	}
+
+	app.rules = append(app.rules, rules...)
+
+	return nil
+}
+
+func (app *Application) Rules() []validationcontract.Rule {
+	app.mu.RLock()
+	defer app.mu.RUnlock()
+
+	return app.rules
+}
+
+func (app *Application) customRules() map[string]validationcontract.Rule {
+	app.mu.RLock()
+	defer app.mu.RUnlock()
+
+	rules := make(map[string]validationcontract.Rule, len(app.rules))
+	for _, rule := range app.rules {
+		rules[rule.Signature()] = rule
+	}
+
+	return rules
+}
@@ -0,0 +1,193 @@
+package validation
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	validationcontract "github.com/goravel/framework/contracts/validation"
+)
+
+type Uppercase struct {
+}
+
+func (receiver *Uppercase) Signature() string {
+	return "uppercase"
+}
+
+func (receiver *Uppercase) Passes(data map[string]interface{}, val interface{}, options ...string) bool {
+	str, ok := val.(string)
+
+	return ok && str != "" && str == strings.ToUpper(str)
+}
+
+func (receiver *Uppercase) Message() string {
+	return "The :attribute must be uppercase."
+}
+
+func TestMake(t *testing.T) {
+	tests := []struct {
+		description  string
+		data         interface{}
+		rules        map[string]string
+		options      []validationcontract.Option
+		expectErr    bool
+		expectFails  bool
+		expectErrors map[string]map[string]string
+	}{
+		{
+			description: "empty rules",
+			data:        map[string]interface{}{"name": "goravel"},
+			rules:       map[string]string{},
+			expectErr:   true,
+		},
+		{
+			description: "unknown rule",
+			data:        map[string]interface{}{"name": "goravel"},
+			rules:       map[string]string{"name": "required|unknown"},
+			expectErr:   true,
+		},
+		{
+			description: "passes",
+			data:        map[string]interface{}{"name": "goravel", "email": "hello@goravel.dev", "age": float64(18)},
+			rules:       map[string]string{"name": "required|string|max:255", "email": "required|email", "age": "integer|between:1,120"},
+		},
+		{
+			description: "required and email fail",
+			data:        map[string]interface{}{"email": "goravel"},
+			rules:       map[string]string{"name": "required", "email": "required|email"},
+			expectFails: true,
+			expectErrors: map[string]map[string]string{
+				"name":  {"required": "The name field is required."},
+				"email": {"email": "The email must be a valid email address."},
+			},
+		},
+		{
+			description: "optional field is skipped when empty",
+			data:        map[string]interface{}{"name": ""},
+			rules:       map[string]string{"name": "email|max:3"},
+		},
+		{
+			description: "size rules depend on the type",
+			data:        url.Values{"name": {"goravel"}, "age": {"200"}, "tags": {"a", "b", "c"}},
+			rules:       map[string]string{"name": "max:3", "age": "numeric|max:150", "tags": "array|max:2"},
+			expectFails: true,
+			expectErrors: map[string]map[string]string{
+				"name": {"max": "The name may not be greater than 3 characters."},
+				"age":  {"max": "The age may not be greater than 150."},
+				"tags": {"max": "The tags may not have more than 2 items."},
+			},
+		},
+		{
+			description: "custom messages and attributes",
+			data:        map[string]string{"user_name": ""},
+			rules:       map[string]string{"user_name": "required", "email": "required"},
+			options: []validationcontract.Option{
+				Messages(map[string]string{"user_name.required": "Please enter :attribute.", "required": ":attribute is missing."}),
+				Attributes(map[string]string{"user_name": "your name"}),
+			},
+			expectFails: true,
+			expectErrors: map[string]map[string]string{
+				"user_name": {"required": "Please enter your name."},
+				"email":     {"required": "email is missing."},
+			},
+		},
+		{
+			description: "comparison rules",
+			data: map[string]interface{}{
+				"password":              "secret",
+				"password_confirmation": "other",
+				"role":                  "guest",
+				"nickname":              "Goravel",
+			},
+			rules: map[string]string{
+				"password": "confirmed",
+				"role":     "in:admin,user",
+				"nickname": "same:password",
+				"company":  "required_if:role,guest",
+			},
+			expectFails: true,
+			expectErrors: map[string]map[string]string{
+				"password": {"confirmed": "The password confirmation does not match."},
+				"role":     {"in": "The selected role is invalid."},
+				"nickname": {"same": "The nickname and password must match."},
+				"company":  {"required_if": "The company field is required when role is guest."},
+			},
+		},
+		{
+			description: "nested data",
+			data:        map[string]interface{}{"user": map[string]interface{}{"email": "goravel"}},
+			rules:       map[string]string{"user.email": "email"},
+			expectFails: true,
+			expectErrors: map[string]map[string]string{
+				"user.email": {"email": "The user.email must be a valid email address."},
+			},
+		},
+		{
+			description: "struct data",
+			data: struct {
+				Name string `json:"name"`
+			}{Name: "Goravel"},
+			rules:       map[string]string{"name": "required|alpha|uppercase"},
+			expectFails: true,
+			expectErrors: map[string]map[string]string{
+				"name": {"uppercase": "The name must be uppercase."},
+			},
+		},
+	}
+
+	app := NewApplication()
+	assert.Nil(t, app.AddRules([]validationcontract.Rule{&Uppercase{}}))
+	assert.NotNil(t, app.AddRules([]validationcontract.Rule{&Uppercase{}}))
+
+	for _, test := range tests {
+		validator, err := app.Make(test.data, test.rules, test.options...)
+		if test.expectErr {
+			assert.NotNil(t, err, test.description)
+			continue
+		}
+
+		assert.Nil(t, err, test.description)
+		assert.Equal(t, test.expectFails, validator.Fails(), test.description)
+		if test.expectFails {
+			assert.Equal(t, test.expectErrors, validator.Errors().All(), test.description)
+		} else {
+			assert.Nil(t, validator.Errors(), test.description)
+		}
+	}
+}
+
+func TestBind(t *testing.T) {
+	app := NewApplication()
+	validator, err := app.Make(url.Values{"name": {"Goravel"}, "age": {"18"}}, map[string]string{"name": "required", "age": "integer"})
+	assert.Nil(t, err)
+	assert.False(t, validator.Fails())
+
+	var user struct {
+		Name string `form:"name"`
+		Age  int    `form:"age"`
+	}
+	assert.Nil(t, validator.Bind(&user))
+	assert.Equal(t, "Goravel", user.Name)
+	assert.Equal(t, 18, user.Age)
+}
+
+func TestErrorsOne(t *testing.T) {
+	app := NewApplication()
+	validator, err := app.Make(map[string]interface{}{"name": "a"}, map[string]string{"name": "min:2|email"})
+	assert.Nil(t, err)
+	assert.True(t, validator.Errors().Has("name"))
+	assert.False(t, validator.Errors().Has("email"))
+	assert.Equal(t, "The name must be at least 2 characters.", validator.Errors().One())
+	assert.Equal(t, "The name must be at least 2 characters.", validator.Errors().One("name"))
+	assert.Equal(t, "", validator.Errors().One("email"))
+}
+
+func TestValuesToMap(t *testing.T) {
+	assert.Equal(t, map[string]interface{}{
+		"name": "goravel",
+		"tags": []interface{}{"a", "b"},
+	}, ValuesToMap(url.Values{"name": {"goravel"}, "tags[]": {"a", "b"}}))
+}
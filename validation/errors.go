@@ -0,0 +1,58 @@
+package validation
+
+type Errors struct {
+	fields   []string
+	messages map[string]map[string]string
+	rules    map[string][]string
+}
+
+func NewErrors() *Errors {
+	return &Errors{
+		messages: make(map[string]map[string]string),
+		rules:    make(map[string][]string),
+	}
+}
+
+func (e *Errors) One(key ...string) string {
+	field := ""
+	if len(key) > 0 {
+		field = key[0]
+	} else if len(e.fields) > 0 {
+		field = e.fields[0]
+	}
+
+	rules := e.rules[field]
+	if len(rules) == 0 {
+		return ""
+	}
+
+	return e.messages[field][rules[0]]
+}
+
+func (e *Errors) Get(key string) map[string]string {
+	return e.messages[key]
+}
+
+func (e *Errors) All() map[string]map[string]string {
+	return e.messages
+}
+
+func (e *Errors) Has(key string) bool {
+	return len(e.messages[key]) > 0
+}
+
+func (e *Errors) Empty() bool {
+	return len(e.messages) == 0
+}
+
+func (e *Errors) add(field, rule, message string) {
+	if _, exist := e.messages[field]; !exist {
+		e.fields = append(e.fields, field)
+		e.messages[field] = make(map[string]string)
+	}
+	if _, exist := e.messages[field][rule]; !exist {
+		e.rules[field] = append(e.rules[field], rule)
+	}
+
+	e.messages[field][rule] = message
+}
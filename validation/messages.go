@@ -0,0 +1,97 @@
+package validation
+
+import (
+	"strings"
+)
+
+var defaultMessages = map[string]string{
+	"accepted":         "The :attribute must be accepted.",
+	"alpha":            "The :attribute may only contain letters.",
+	"alpha_dash":       "The :attribute may only contain letters, numbers, dashes and underscores.",
+	"alpha_num":        "The :attribute may only contain letters and numbers.",
+	"array":            "The :attribute must be an array.",
+	"between.array":    "The :attribute must have between :min and :max items.",
+	"between.numeric":  "The :attribute must be between :min and :max.",
+	"between.string":   "The :attribute must be between :min and :max characters.",
+	"boolean":          "The :attribute field must be true or false.",
+	"confirmed":        "The :attribute confirmation does not match.",
+	"date":             "The :attribute is not a valid date.",
+	"different":        "The :attribute and :other must be different.",
+	"email":            "The :attribute must be a valid email address.",
+	"ends_with":        "The :attribute must end with one of the following: :values.",
+	"in":               "The selected :attribute is invalid.",
+	"integer":          "The :attribute must be an integer.",
+	"ip":               "The :attribute must be a valid IP address.",
+	"ipv4":             "The :attribute must be a valid IPv4 address.",
+	"ipv6":             "The :attribute must be a valid IPv6 address.",
+	"json":             "The :attribute must be a valid JSON string.",
+	"max.array":        "The :attribute may not have more than :max items.",
+	"max.numeric":      "The :attribute may not be greater than :max.",
+	"max.string":       "The :attribute may not be greater than :max characters.",
+	"min.array":        "The :attribute must have at least :min items.",
+	"min.numeric":      "The :attribute must be at least :min.",
+	"min.string":       "The :attribute must be at least :min characters.",
+	"not_in":           "The selected :attribute is invalid.",
+	"not_regex":        "The :attribute format is invalid.",
+	"numeric":          "The :attribute must be a number.",
+	"regex":            "The :attribute format is invalid.",
+	"required":         "The :attribute field is required.",
+	"required_if":      "The :attribute field is required when :other is :value.",
+	"required_unless":  "The :attribute field is required unless :other is in :values.",
+	"required_with":    "The :attribute field is required when :values is present.",
+	"required_without": "The :attribute field is required when :values is not present.",
+	"same":             "The :attribute and :other must match.",
+	"size.array":       "The :attribute must contain :size items.",
+	"size.numeric":     "The :attribute must be :size.",
+	"size.string":      "The :attribute must be :size characters.",
+	"starts_with":      "The :attribute must start with one of the following: :values.",
+	"string":           "The :attribute must be a string.",
+	"url":              "The :attribute format is invalid.",
+	"uuid":             "The :attribute must be a valid UUID.",
+}
+
+func replacePlaceholders(message, attribute string, item rule, attributeName func(field string) string) string {
+	replacements := []string{":attribute", attribute}
+	switch item.name {
+	case "min":
+		replacements = append(replacements, ":min", parameter(item.parameters, 0))
+	case "max":
+		replacements = append(replacements, ":max", parameter(item.parameters, 0))
+	case "between":
+		replacements = append(replacements, ":min", parameter(item.parameters, 0), ":max", parameter(item.parameters, 1))
+	case "size":
+		replacements = append(replacements, ":size", parameter(item.parameters, 0))
+	case "same", "different":
+		replacements = append(replacements, ":other", attributeName(parameter(item.parameters, 0)))
+	case "required_if":
+		replacements = append(replacements, ":other", attributeName(parameter(item.parameters, 0)), ":value", strings.Join(tail(item.parameters), ", "))
+	case "required_unless":
+		replacements = append(replacements, ":other", attributeName(parameter(item.parameters, 0)), ":values", strings.Join(tail(item.parameters), ", "))
+	case "required_with", "required_without":
+		var attributes []string
+		for _, field := range item.parameters {
+			attributes = append(attributes, attributeName(field))
+		}
+		replacements = append(replacements, ":values", strings.Join(attributes, " / "))
+	default:
+		replacements = append(replacements, ":values", strings.Join(item.parameters, ", "))
+	}
+
+	return strings.NewReplacer(replacements...).Replace(message)
+}
+
+func parameter(parameters []string, index int) string {
+	if len(parameters) > index {
+		return parameters[index]
+	}
+
+	return ""
+}
+
+func tail(parameters []string) []string {
+	if len(parameters) > 1 {
+		return parameters[1:]
+	}
+
+	return nil
+}
@@ -0,0 +1,17 @@
+package validation
+
+import (
+	validationcontract "github.com/goravel/framework/contracts/validation"
+)
+
+func Messages(messages map[string]string) validationcontract.Option {
+	return func(options *validationcontract.Options) {
+		options.Messages = messages
+	}
+}
+
+func Attributes(attributes map[string]string) validationcontract.Option {
+	return func(options *validationcontract.Options) {
+		options.Attributes = attributes
+	}
+}
@@ -0,0 +1,509 @@
+package validation
+
+import (
+	"encoding/json"
+	"net"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/spf13/cast"
+)
+
+type ruleContext struct {
+	validator  *Validator
+	field      string
+	value      interface{}
+	exist      bool
+	parameters []string
+	rules      []rule
+}
+
+type ruleFunc func(ctx *ruleContext) bool
+
+var (
+	emailRegex = regexp.MustCompile("^[a-zA-Z0-9.!#$%&'*+/=?^_`{|}~-]+@[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(?:\\.[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$")
+	uuidRegex  = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+	dateLayouts = []string{
+		time.RFC3339,
+		"2006-01-02 15:04:05",
+		"2006-01-02",
+		"2006/01/02 15:04:05",
+		"2006/01/02",
+	}
+
+	// implicitRules run even if the value is empty.
+	implicitRules = map[string]struct{}{
+		"accepted":         {},
+		"required":         {},
+		"required_if":      {},
+		"required_unless":  {},
+		"required_with":    {},
+		"required_without": {},
+	}
+
+	// sizeRules compare numbers, string lengths or item counts depending on the value type.
+	sizeRules = map[string]struct{}{
+		"between": {},
+		"max":     {},
+		"min":     {},
+		"size":    {},
+	}
+
+	builtinRules map[string]ruleFunc
+)
+
+func init() {
+	builtinRules = map[string]ruleFunc{
+		"accepted":         accepted,
+		"alpha":            alpha,
+		"alpha_dash":       alphaDash,
+		"alpha_num":        alphaNum,
+		"array":            array,
+		"between":          between,
+		"boolean":          boolean,
+		"confirmed":        confirmed,
+		"date":             date,
+		"different":        different,
+		"email":            email,
+		"ends_with":        endsWith,
+		"in":               in,
+		"integer":          integer,
+		"ip":               ip,
+		"ipv4":             ipv4,
+		"ipv6":             ipv6,
+		"json":             validJson,
+		"max":              maxSize,
+		"min":              minSize,
+		"not_in":           notIn,
+		"not_regex":        notRegex,
+		"nullable":         nullable,
+		"numeric":          numeric,
+		"regex":            regex,
+		"required":         required,
+		"required_if":      requiredIf,
+		"required_unless":  requiredUnless,
+		"required_with":    requiredWith,
+		"required_without": requiredWithout,
+		"same":             same,
+		"size":             size,
+		"starts_with":      startsWith,
+		"string":           isString,
+		"url":              validUrl,
+		"uuid":             validUuid,
+	}
+}
+
+func accepted(ctx *ruleContext) bool {
+	return in(&ruleContext{value: ctx.value, parameters: []string{"yes", "on", "1", "true"}})
+}
+
+func alpha(ctx *ruleContext) bool {
+	return matchRunes(ctx.value, func(r rune) bool {
+		return unicode.IsLetter(r)
+	})
+}
+
+func alphaDash(ctx *ruleContext) bool {
+	return matchRunes(ctx.value, func(r rune) bool {
+		return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-' || r == '_'
+	})
+}
+
+func alphaNum(ctx *ruleContext) bool {
+	return matchRunes(ctx.value, func(r rune) bool {
+		return unicode.IsLetter(r) || unicode.IsDigit(r)
+	})
+}
+
+func array(ctx *ruleContext) bool {
+	switch reflect.ValueOf(ctx.value).Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return true
+	}
+
+	return false
+}
+
+func between(ctx *ruleContext) bool {
+	if len(ctx.parameters) != 2 {
+		return false
+	}
+
+	return minSize(&ruleContext{value: ctx.value, parameters: ctx.parameters[:1], rules: ctx.rules}) &&
+		maxSize(&ruleContext{value: ctx.value, parameters: ctx.parameters[1:], rules: ctx.rules})
+}
+
+func boolean(ctx *ruleContext) bool {
+	switch value := ctx.value.(type) {
+	case bool:
+		return true
+	case string:
+		return value == "0" || value == "1" || value == "true" || value == "false"
+	}
+
+	number, err := cast.ToFloat64E(ctx.value)
+
+	return err == nil && (number == 0 || number == 1)
+}
+
+func confirmed(ctx *ruleContext) bool {
+	confirmation, exist := ctx.validator.value(ctx.field + "_confirmation")
+
+	return exist && cast.ToString(confirmation) == cast.ToString(ctx.value)
+}
+
+func date(ctx *ruleContext) bool {
+	switch value := ctx.value.(type) {
+	case time.Time:
+		return true
+	case string:
+		for _, layout := range dateLayouts {
+			if _, err := time.Parse(layout, value); err == nil {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func different(ctx *ruleContext) bool {
+	return !same(ctx)
+}
+
+func email(ctx *ruleContext) bool {
+	value, ok := ctx.value.(string)
+
+	return ok && emailRegex.MatchString(value)
+}
+
+func endsWith(ctx *ruleContext) bool {
+	value, ok := ctx.value.(string)
+	if !ok {
+		return false
+	}
+	for _, suffix := range ctx.parameters {
+		if strings.HasSuffix(value, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func in(ctx *ruleContext) bool {
+	value, err := cast.ToStringE(ctx.value)
+	if err != nil {
+		return false
+	}
+	for _, item := range ctx.parameters {
+		if item == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func integer(ctx *ruleContext) bool {
+	switch value := ctx.value.(type) {
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return true
+	case float32:
+		return value == float32(int64(value))
+	case float64:
+		return value == float64(int64(value))
+	case string:
+		_, err := strconv.ParseInt(value, 10, 64)
+
+		return err == nil
+	}
+
+	return false
+}
+
+func ip(ctx *ruleContext) bool {
+	value, ok := ctx.value.(string)
+
+	return ok && net.ParseIP(value) != nil
+}
+
+func ipv4(ctx *ruleContext) bool {
+	value, ok := ctx.value.(string)
+
+	return ok && net.ParseIP(value) != nil && strings.Contains(value, ".")
+}
+
+func ipv6(ctx *ruleContext) bool {
+	value, ok := ctx.value.(string)
+
+	return ok && net.ParseIP(value) != nil && strings.Contains(value, ":")
+}
+
+func validJson(ctx *ruleContext) bool {
+	value, ok := ctx.value.(string)
+
+	return ok && json.Valid([]byte(value))
+}
+
+func maxSize(ctx *ruleContext) bool {
+	limit, err := strconv.ParseFloat(parameter(ctx.parameters, 0), 64)
+	if err != nil {
+		return false
+	}
+	size, ok := sizeOf(ctx.value, ctx.rules)
+
+	return ok && size <= limit
+}
+
+func minSize(ctx *ruleContext) bool {
+	limit, err := strconv.ParseFloat(parameter(ctx.parameters, 0), 64)
+	if err != nil {
+		return false
+	}
+	size, ok := sizeOf(ctx.value, ctx.rules)
+
+	return ok && size >= limit
+}
+
+func notIn(ctx *ruleContext) bool {
+	return !in(ctx)
+}
+
+func notRegex(ctx *ruleContext) bool {
+	value, ok := ctx.value.(string)
+	if !ok {
+		return false
+	}
+	compiled, err := regexp.Compile(parameter(ctx.parameters, 0))
+
+	return err == nil && !compiled.MatchString(value)
+}
+
+func nullable(ctx *ruleContext) bool {
+	return true
+}
+
+func numeric(ctx *ruleContext) bool {
+	if value, ok := ctx.value.(string); ok {
+		_, err := strconv.ParseFloat(value, 64)
+
+		return err == nil
+	}
+	if _, ok := ctx.value.(bool); ok {
+		return false
+	}
+
+	_, err := cast.ToFloat64E(ctx.value)
+
+	return err == nil
+}
+
+func regex(ctx *ruleContext) bool {
+	value, ok := ctx.value.(string)
+	if !ok {
+		return false
+	}
+	compiled, err := regexp.Compile(parameter(ctx.parameters, 0))
+
+	return err == nil && compiled.MatchString(value)
+}
+
+func required(ctx *ruleContext) bool {
+	return ctx.exist && !isEmpty(ctx.value)
+}
+
+func requiredIf(ctx *ruleContext) bool {
+	if len(ctx.parameters) < 2 {
+		return false
+	}
+	other, _ := ctx.validator.value(ctx.parameters[0])
+	if !in(&ruleContext{value: other, parameters: ctx.parameters[1:]}) {
+		return true
+	}
+
+	return required(ctx)
+}
+
+func requiredUnless(ctx *ruleContext) bool {
+	if len(ctx.parameters) < 2 {
+		return false
+	}
+	other, _ := ctx.validator.value(ctx.parameters[0])
+	if in(&ruleContext{value: other, parameters: ctx.parameters[1:]}) {
+		return true
+	}
+
+	return required(ctx)
+}
+
+func requiredWith(ctx *ruleContext) bool {
+	for _, field := range ctx.parameters {
+		if other, _ := ctx.validator.value(field); !isEmpty(other) {
+			return required(ctx)
+		}
+	}
+
+	return true
+}
+
+func requiredWithout(ctx *ruleContext) bool {
+	for _, field := range ctx.parameters {
+		if other, _ := ctx.validator.value(field); isEmpty(other) {
+			return required(ctx)
+		}
+	}
+
+	return true
+}
+
+func same(ctx *ruleContext) bool {
+	other, exist := ctx.validator.value(parameter(ctx.parameters, 0))
+
+	return exist && reflect.DeepEqual(other, ctx.value)
+}
+
+func size(ctx *ruleContext) bool {
+	limit, err := strconv.ParseFloat(parameter(ctx.parameters, 0), 64)
+	if err != nil {
+		return false
+	}
+	size, ok := sizeOf(ctx.value, ctx.rules)
+
+	return ok && size == limit
+}
+
+func startsWith(ctx *ruleContext) bool {
+	value, ok := ctx.value.(string)
+	if !ok {
+		return false
+	}
+	for _, prefix := range ctx.parameters {
+		if strings.HasPrefix(value, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isString(ctx *ruleContext) bool {
+	_, ok := ctx.value.(string)
+
+	return ok
+}
+
+func validUrl(ctx *ruleContext) bool {
+	value, ok := ctx.value.(string)
+	if !ok {
+		return false
+	}
+	parsed, err := url.ParseRequestURI(value)
+
+	return err == nil && parsed.Scheme != "" && parsed.Host != ""
+}
+
+func validUuid(ctx *ruleContext) bool {
+	value, ok := ctx.value.(string)
+
+	return ok && uuidRegex.MatchString(value)
+}
+
+func matchRunes(value interface{}, match func(r rune) bool) bool {
+	str, ok := value.(string)
+	if !ok {
+		return false
+	}
+	for _, r := range str {
+		if !match(r) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// sizeOf Get the size of a value: numbers are compared by value if the field has a numeric rule,
+// strings by length of characters, slices and maps by count of items.
+func sizeOf(value interface{}, rules []rule) (float64, bool) {
+	if sizeType(value, rules) == "numeric" {
+		number, err := cast.ToFloat64E(value)
+
+		return number, err == nil
+	}
+
+	if str, ok := value.(string); ok {
+		return float64(utf8.RuneCountInString(str)), true
+	}
+
+	reflectValue := reflect.ValueOf(value)
+	switch reflectValue.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return float64(reflectValue.Len()), true
+	}
+
+	return 0, false
+}
+
+func sizeType(value interface{}, rules []rule) string {
+	if hasRule(rules, "numeric") || hasRule(rules, "integer") {
+		return "numeric"
+	}
+
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return "numeric"
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return "array"
+	}
+
+	return "string"
+}
+
+func isEmpty(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	if str, ok := value.(string); ok {
+		return strings.TrimSpace(str) == ""
+	}
+
+	reflectValue := reflect.ValueOf(value)
+	switch reflectValue.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return reflectValue.Len() == 0
+	case reflect.Ptr, reflect.Interface:
+		return reflectValue.IsNil()
+	}
+
+	return false
+}
+
+func hasRule(rules []rule, name string) bool {
+	for _, item := range rules {
+		if item.name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasImplicitRule(rules []rule) bool {
+	for _, item := range rules {
+		if _, ok := implicitRules[item.name]; ok {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,16 @@
+package validation
+
+import (
+	"github.com/goravel/framework/facades"
+)
+
+type ServiceProvider struct {
+}
+
+func (validation *ServiceProvider) Register() {
+	facades.Validation = NewApplication()
+}
+
+func (validation *ServiceProvider) Boot() {
+
+}
@@ -0,0 +1,262 @@
+package validation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+
+	validationcontract "github.com/goravel/framework/contracts/validation"
+)
+
+type Validator struct {
+	data        map[string]interface{}
+	options     *validationcontract.Options
+	customRules map[string]validationcontract.Rule
+	errors      *Errors
+}
+
+type rule struct {
+	name       string
+	parameters []string
+}
+
+func NewValidator(data map[string]interface{}, options *validationcontract.Options, customRules map[string]validationcontract.Rule) *Validator {
+	return &Validator{
+		data:        data,
+		options:     options,
+		customRules: customRules,
+		errors:      NewErrors(),
+	}
+}
+
+func (v *Validator) Bind(ptr interface{}) error {
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		TagName:          "form",
+		WeaklyTypedInput: true,
+		Result:           ptr,
+	})
+	if err != nil {
+		return err
+	}
+
+	return decoder.Decode(v.data)
+}
+
+func (v *Validator) Errors() validationcontract.Errors {
+	if v.errors.Empty() {
+		return nil
+	}
+
+	return v.errors
+}
+
+func (v *Validator) Fails() bool {
+	return !v.errors.Empty()
+}
+
+func (v *Validator) validate(rules map[string]string) error {
+	fields := make([]string, 0, len(rules))
+	for field := range rules {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	for _, field := range fields {
+		parsedRules, err := v.parseRules(rules[field])
+		if err != nil {
+			return err
+		}
+
+		val, exist := v.value(field)
+		if isEmpty(val) && !hasImplicitRule(parsedRules) {
+			continue
+		}
+		if val == nil && hasRule(parsedRules, "nullable") {
+			continue
+		}
+
+		for _, item := range parsedRules {
+			if v.passes(field, val, exist, item, parsedRules) {
+				continue
+			}
+
+			v.errors.add(field, item.name, v.message(field, val, item, parsedRules))
+		}
+	}
+
+	return nil
+}
+
+func (v *Validator) parseRules(rules string) ([]rule, error) {
+	var parsedRules []rule
+	for _, item := range strings.Split(rules, "|") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		name, parameters, _ := strings.Cut(item, ":")
+		parsedRule := rule{name: name}
+		if parameters != "" {
+			if name == "regex" || name == "not_regex" {
+				parsedRule.parameters = []string{parameters}
+			} else {
+				parsedRule.parameters = strings.Split(parameters, ",")
+			}
+		}
+
+		_, isBuiltin := builtinRules[name]
+		_, isCustom := v.customRules[name]
+		if !isBuiltin && !isCustom {
+			return nil, fmt.Errorf("rule %s doesn't exist", name)
+		}
+
+		parsedRules = append(parsedRules, parsedRule)
+	}
+
+	return parsedRules, nil
+}
+
+func (v *Validator) passes(field string, val interface{}, exist bool, item rule, rules []rule) bool {
+	if customRule, ok := v.customRules[item.name]; ok {
+		return customRule.Passes(v.data, val, item.parameters...)
+	}
+
+	return builtinRules[item.name](&ruleContext{
+		validator:  v,
+		field:      field,
+		value:      val,
+		exist:      exist,
+		parameters: item.parameters,
+		rules:      rules,
+	})
+}
+
+func (v *Validator) message(field string, val interface{}, item rule, rules []rule) string {
+	var message string
+	if v.options.Messages != nil {
+		if customMessage, ok := v.options.Messages[field+"."+item.name]; ok {
+			message = customMessage
+		} else if customMessage, ok := v.options.Messages[item.name]; ok {
+			message = customMessage
+		}
+	}
+
+	if message == "" {
+		if customRule, ok := v.customRules[item.name]; ok {
+			message = customRule.Message()
+		} else if _, isSizeRule := sizeRules[item.name]; isSizeRule {
+			message = defaultMessages[item.name+"."+sizeType(val, rules)]
+		} else {
+			message = defaultMessages[item.name]
+		}
+	}
+
+	return replacePlaceholders(message, v.attribute(field), item, v.attribute)
+}
+
+func (v *Validator) attribute(field string) string {
+	if v.options.Attributes != nil {
+		if attribute, ok := v.options.Attributes[field]; ok {
+			return attribute
+		}
+	}
+
+	return strings.ReplaceAll(field, "_", " ")
+}
+
+// value Get the value of a field, supports dot notation for nested data: user.name
+func (v *Validator) value(field string) (interface{}, bool) {
+	return getValue(v.data, field)
+}
+
+func getValue(data map[string]interface{}, field string) (interface{}, bool) {
+	if val, exist := data[field]; exist {
+		return val, true
+	}
+
+	key, rest, found := strings.Cut(field, ".")
+	if !found {
+		return nil, false
+	}
+
+	switch nested := data[key].(type) {
+	case map[string]interface{}:
+		return getValue(nested, rest)
+	case []interface{}:
+		index, rest, _ := strings.Cut(rest, ".")
+		i := 0
+		if _, err := fmt.Sscanf(index, "%d", &i); err != nil || i < 0 || i >= len(nested) {
+			return nil, false
+		}
+		if rest == "" {
+			return nested[i], true
+		}
+		if nestedMap, ok := nested[i].(map[string]interface{}); ok {
+			return getValue(nestedMap, rest)
+		}
+	}
+
+	return nil, false
+}
+
+func dataToMap(data interface{}) (map[string]interface{}, error) {
+	switch realData := data.(type) {
+	case map[string]interface{}:
+		return realData, nil
+	case map[string]string:
+		dataMap := make(map[string]interface{}, len(realData))
+		for key, value := range realData {
+			dataMap[key] = value
+		}
+
+		return dataMap, nil
+	case url.Values:
+		return ValuesToMap(realData), nil
+	case map[string][]string:
+		return ValuesToMap(realData), nil
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(data))
+	if value.Kind() != reflect.Struct {
+		return nil, errors.New("data must be a map or a struct")
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var dataMap map[string]interface{}
+	if err := json.Unmarshal(jsonData, &dataMap); err != nil {
+		return nil, err
+	}
+
+	return dataMap, nil
+}
+
+// ValuesToMap Convert the values of a query or a form to a map, a key with one value is mapped to the value, the others
+// are mapped to a slice, the suffix [] of the keys is trimmed.
+func ValuesToMap(values map[string][]string) map[string]interface{} {
+	dataMap := make(map[string]interface{}, len(values))
+	for key, value := range values {
+		key = strings.TrimSuffix(key, "[]")
+		if len(value) == 1 {
+			dataMap[key] = value[0]
+		} else {
+			items := make([]interface{}, len(value))
+			for i, item := range value {
+				items[i] = item
+			}
+			dataMap[key] = items
+		}
+	}
+
+	return dataMap
+}
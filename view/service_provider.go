@@ -0,0 +1,16 @@
+package view
+
+import (
+	"github.com/goravel/framework/facades"
+)
+
+type ServiceProvider struct {
+}
+
+func (view *ServiceProvider) Register() {
+	facades.View = NewView()
+}
+
+func (view *ServiceProvider) Boot() {
+
+}
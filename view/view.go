@@ -0,0 +1,216 @@
+package view
+
+import (
+	"errors"
+	"html/template"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/goravel/framework/facades"
+)
+
+// View Render the views via html/template, the views in the view.includes directories (layouts and partials
+// by default) are parsed together with every view, so they can be used by name: {{ template "layouts/app" . }}
+type View struct {
+	mu     sync.RWMutex
+	fsys   fs.FS
+	funcs  template.FuncMap
+	shared map[string]interface{}
+	cache  map[string]*template.Template
+}
+
+func NewView() *View {
+	v := &View{
+		shared: make(map[string]interface{}),
+		cache:  make(map[string]*template.Template),
+	}
+	v.funcs = template.FuncMap{
+		"shared": v.Shared,
+	}
+
+	return v
+}
+
+func (v *View) Exists(name string) bool {
+	_, err := fs.Stat(v.filesystem(), v.filename(name))
+
+	return err == nil
+}
+
+func (v *View) Share(key string, value interface{}) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.shared[key] = value
+}
+
+func (v *View) Shared(key string, def ...interface{}) interface{} {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+
+	if value, exist := v.shared[key]; exist {
+		return value
+	}
+	if len(def) > 0 {
+		return def[0]
+	}
+
+	return nil
+}
+
+func (v *View) GetShared() map[string]interface{} {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+
+	shared := make(map[string]interface{}, len(v.shared))
+	for key, value := range v.shared {
+		shared[key] = value
+	}
+
+	return shared
+}
+
+func (v *View) Funcs(funcs map[string]interface{}) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	for name, fn := range funcs {
+		v.funcs[name] = fn
+	}
+	v.cache = make(map[string]*template.Template)
+}
+
+func (v *View) FS(fsys fs.FS) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.fsys = fsys
+	v.cache = make(map[string]*template.Template)
+}
+
+func (v *View) Render(w io.Writer, name string, data interface{}) error {
+	tmpl, err := v.template(name)
+	if err != nil {
+		return err
+	}
+
+	return tmpl.Execute(w, v.data(data))
+}
+
+// template Get the parsed view, the views are parsed on every render in app.debug, so they can be hot reloaded.
+func (v *View) template(name string) (*template.Template, error) {
+	debug := facades.Config.GetBool("app.debug")
+	if !debug {
+		v.mu.RLock()
+		tmpl, exist := v.cache[name]
+		v.mu.RUnlock()
+		if exist {
+			return tmpl, nil
+		}
+	}
+
+	tmpl, err := v.parse(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if !debug {
+		v.mu.Lock()
+		v.cache[name] = tmpl
+		v.mu.Unlock()
+	}
+
+	return tmpl, nil
+}
+
+// parse Parse the includes first, so the blocks of them can be overridden by the definitions of the view.
+func (v *View) parse(name string) (*template.Template, error) {
+	fsys := v.filesystem()
+	content, err := fs.ReadFile(fsys, v.filename(name))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, errors.New("[view] the view " + name + " doesn't exist")
+		}
+
+		return nil, err
+	}
+
+	v.mu.RLock()
+	tmpl := template.New(name).Funcs(v.funcs)
+	v.mu.RUnlock()
+
+	extension := v.extension()
+	includes, _ := facades.Config.Get("view.includes", []string{"layouts", "partials"}).([]string)
+	for _, include := range includes {
+		err := fs.WalkDir(fsys, include, func(file string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				if errors.Is(err, fs.ErrNotExist) {
+					return fs.SkipDir
+				}
+
+				return err
+			}
+			if entry.IsDir() || path.Ext(file) != extension {
+				return nil
+			}
+
+			content, err := fs.ReadFile(fsys, file)
+			if err != nil {
+				return err
+			}
+
+			_, err = tmpl.New(strings.TrimSuffix(file, extension)).Parse(string(content))
+
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return tmpl.Parse(string(content))
+}
+
+// data Merge the shared data into the data if it's a map, the shared data can be got via {{ shared "key" }} as well.
+func (v *View) data(data interface{}) interface{} {
+	if data == nil {
+		return v.GetShared()
+	}
+
+	value := reflect.ValueOf(data)
+	if value.Kind() != reflect.Map || value.Type().Key().Kind() != reflect.String {
+		return data
+	}
+
+	merged := v.GetShared()
+	iter := value.MapRange()
+	for iter.Next() {
+		merged[iter.Key().String()] = iter.Value().Interface()
+	}
+
+	return merged
+}
+
+func (v *View) filesystem() fs.FS {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+
+	if v.fsys != nil {
+		return v.fsys
+	}
+
+	return os.DirFS(facades.Config.GetString("view.path", "resources/views"))
+}
+
+func (v *View) filename(name string) string {
+	return strings.Trim(name, "/") + v.extension()
+}
+
+func (v *View) extension() string {
+	return facades.Config.GetString("view.extension", ".tmpl")
+}
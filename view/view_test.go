@@ -0,0 +1,84 @@
+package view
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	testingmock "github.com/goravel/framework/testing/mock"
+)
+
+var views = fstest.MapFS{
+	"layouts/app.tmpl":     {Data: []byte(`<title>{{ block "title" . }}Goravel{{ end }}</title>{{ template "partials/header" . }}{{ template "content" . }}`)},
+	"partials/header.tmpl": {Data: []byte(`<h1>{{ .app }}</h1>`)},
+	"users/show.tmpl":      {Data: []byte(`{{ define "title" }}{{ .name }}{{ end }}{{ define "content" }}<p>{{ upper .name }}</p>{{ end }}{{ template "layouts/app" . }}`)},
+	"users/index.tmpl":     {Data: []byte(`{{ define "content" }}<p>{{ shared "app" }}</p>{{ end }}{{ template "layouts/app" . }}`)},
+	"plain.tmpl":           {Data: []byte(`{{ .Name }}`)},
+}
+
+func TestRender(t *testing.T) {
+	mockConfig := testingmock.Config()
+	mockConfig.On("GetBool", "app.debug").Return(false)
+	mockConfig.On("GetString", "view.extension", ".tmpl").Return(".tmpl")
+	mockConfig.On("Get", "view.includes", []string{"layouts", "partials"}).Return([]string{"layouts", "partials"})
+
+	v := NewView()
+	v.FS(views)
+	v.Share("app", "Goravel")
+	v.Funcs(map[string]interface{}{
+		"upper": strings.ToUpper,
+	})
+
+	assert.True(t, v.Exists("users/show"))
+	assert.False(t, v.Exists("users/missing"))
+	assert.Equal(t, "Goravel", v.Shared("app"))
+	assert.Equal(t, "default", v.Shared("missing", "default"))
+
+	var buffer bytes.Buffer
+	assert.Nil(t, v.Render(&buffer, "users/show", map[string]interface{}{"name": "<goravel>"}))
+	assert.Equal(t, "<title>&lt;goravel&gt;</title><h1>Goravel</h1><p>&lt;GORAVEL&gt;</p>", buffer.String())
+
+	buffer.Reset()
+	assert.Nil(t, v.Render(&buffer, "users/index", nil))
+	assert.Equal(t, "<title>Goravel</title><h1>Goravel</h1><p>Goravel</p>", buffer.String())
+
+	buffer.Reset()
+	assert.Nil(t, v.Render(&buffer, "plain", struct{ Name string }{Name: "goravel"}))
+	assert.Equal(t, "goravel", buffer.String())
+
+	assert.EqualError(t, v.Render(&buffer, "users/missing", nil), "[view] the view users/missing doesn't exist")
+
+	mockConfig.AssertExpectations(t)
+}
+
+func TestHotReload(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "home.tmpl")
+	assert.Nil(t, os.WriteFile(file, []byte("v1"), 0644))
+
+	mockConfig := testingmock.Config()
+	mockConfig.On("GetString", "view.path", "resources/views").Return(dir)
+	mockConfig.On("GetString", "view.extension", ".tmpl").Return(".tmpl")
+	mockConfig.On("Get", "view.includes", mock.Anything).Return([]string{})
+
+	v := NewView()
+	mockConfig.On("GetBool", "app.debug").Return(false).Twice()
+	render := func() string {
+		var buffer bytes.Buffer
+		assert.Nil(t, v.Render(&buffer, "home", nil))
+
+		return buffer.String()
+	}
+	assert.Equal(t, "v1", render())
+	assert.Nil(t, os.WriteFile(file, []byte("v2"), 0644))
+	assert.Equal(t, "v1", render())
+
+	mockConfig.On("GetBool", "app.debug").Return(true)
+	assert.Equal(t, "v2", render())
+}